@@ -0,0 +1,60 @@
+// temporal-breaking reports changes to temporal definitions that break running workflows or
+// existing callers, by comparing two binary FileDescriptorSet files built with imports (e.g.
+// buf build -o head.binpb). It exits with a non-zero status when breaking changes are found
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cludden/protoc-gen-go-temporal/internal/plugin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func main() {
+	basePath := flag.String("base", "", "path to the base descriptor set")
+	headPath := flag.String("head", "", "path to the head descriptor set")
+	flag.Parse()
+	if *basePath == "" || *headPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	base, err := readDescriptorSet(*basePath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	head, err := readDescriptorSet(*headPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	changes, err := plugin.BreakingChanges(base, head)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	if len(changes) > 0 {
+		os.Exit(1)
+	}
+}
+
+// readDescriptorSet reads a binary FileDescriptorSet from the given path
+func readDescriptorSet(path string) (*descriptorpb.FileDescriptorSet, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading descriptor set: %w", err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(b, &set); err != nil {
+		return nil, fmt.Errorf("error decoding descriptor set %s: %w", path, err)
+	}
+	return &set, nil
+}
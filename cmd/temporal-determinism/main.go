@@ -0,0 +1,14 @@
+// temporal-determinism reports non-deterministic code reachable from implementations of
+// generated <Workflow>Workflow interfaces. It is run by go vet:
+//
+//	go vet -vettool=$(which temporal-determinism) ./...
+package main
+
+import (
+	"github.com/cludden/protoc-gen-go-temporal/pkg/determinism"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(determinism.Analyzer)
+}
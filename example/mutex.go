@@ -30,6 +30,11 @@ func (w *Workflows) Mutex(ctx workflow.Context, input *mutexv1.MutexInput) (mute
 // Execute defines the entrypoint to a MutexWorkflow
 func (wf *MutexWorkflow) Execute(ctx workflow.Context) error {
 	for {
+		// continue as new between leases once history grows too large, carrying over pending requests
+		if wf.ShouldContinueAsNew(ctx) {
+			return wf.ContinueAsNew()
+		}
+
 		wf.log.Info("dequeuing lease request")
 		lease := wf.AcquireLease.ReceiveAsync()
 		if lease == nil {
@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: example.proto
+
+// buf:lint:ignore PACKAGE_DIRECTORY_MATCH
+
+package mutexv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Mutex_Mutex_FullMethodName                   = "/mycompany.mutex.v1.Mutex/Mutex"
+	Mutex_SampleWorkflowWithMutex_FullMethodName = "/mycompany.mutex.v1.Mutex/SampleWorkflowWithMutex"
+	Mutex_AcquireLease_FullMethodName            = "/mycompany.mutex.v1.Mutex/AcquireLease"
+	Mutex_LeaseAcquired_FullMethodName           = "/mycompany.mutex.v1.Mutex/LeaseAcquired"
+	Mutex_RenewLease_FullMethodName              = "/mycompany.mutex.v1.Mutex/RenewLease"
+	Mutex_RevokeLease_FullMethodName             = "/mycompany.mutex.v1.Mutex/RevokeLease"
+)
+
+// MutexClient is the client API for Mutex service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MutexClient interface {
+	// Mutex provides a mutex over a shared resource
+	Mutex(ctx context.Context, in *MutexRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SampleWorkflowWithMutex provides an example of a running workflow that uses
+	// a Mutex workflow to prevent concurrent access to a shared resource
+	SampleWorkflowWithMutex(ctx context.Context, in *SampleWorkflowWithMutexRequest, opts ...grpc.CallOption) (*SampleWorkflowWithMutexResponse, error)
+	// AcquireLease enqueues a lease on the given resource
+	AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// LeaseAcquired notifies the calling workflow that a lease has been required
+	LeaseAcquired(ctx context.Context, in *LeaseAcquiredRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RenewLease extends the validity of an existing lease
+	RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// RevokeLease revokes an existing lease
+	RevokeLease(ctx context.Context, in *RevokeLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type mutexClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMutexClient(cc grpc.ClientConnInterface) MutexClient {
+	return &mutexClient{cc}
+}
+
+func (c *mutexClient) Mutex(ctx context.Context, in *MutexRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Mutex_Mutex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mutexClient) SampleWorkflowWithMutex(ctx context.Context, in *SampleWorkflowWithMutexRequest, opts ...grpc.CallOption) (*SampleWorkflowWithMutexResponse, error) {
+	out := new(SampleWorkflowWithMutexResponse)
+	err := c.cc.Invoke(ctx, Mutex_SampleWorkflowWithMutex_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mutexClient) AcquireLease(ctx context.Context, in *AcquireLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Mutex_AcquireLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mutexClient) LeaseAcquired(ctx context.Context, in *LeaseAcquiredRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Mutex_LeaseAcquired_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mutexClient) RenewLease(ctx context.Context, in *RenewLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Mutex_RenewLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mutexClient) RevokeLease(ctx context.Context, in *RevokeLeaseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Mutex_RevokeLease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MutexServer is the server API for Mutex service.
+// All implementations must embed UnimplementedMutexServer
+// for forward compatibility
+type MutexServer interface {
+	// Mutex provides a mutex over a shared resource
+	Mutex(context.Context, *MutexRequest) (*emptypb.Empty, error)
+	// SampleWorkflowWithMutex provides an example of a running workflow that uses
+	// a Mutex workflow to prevent concurrent access to a shared resource
+	SampleWorkflowWithMutex(context.Context, *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error)
+	// AcquireLease enqueues a lease on the given resource
+	AcquireLease(context.Context, *AcquireLeaseRequest) (*emptypb.Empty, error)
+	// LeaseAcquired notifies the calling workflow that a lease has been required
+	LeaseAcquired(context.Context, *LeaseAcquiredRequest) (*emptypb.Empty, error)
+	// RenewLease extends the validity of an existing lease
+	RenewLease(context.Context, *RenewLeaseRequest) (*emptypb.Empty, error)
+	// RevokeLease revokes an existing lease
+	RevokeLease(context.Context, *RevokeLeaseRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedMutexServer()
+}
+
+// UnimplementedMutexServer must be embedded to have forward compatible implementations.
+type UnimplementedMutexServer struct {
+}
+
+func (UnimplementedMutexServer) Mutex(context.Context, *MutexRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mutex not implemented")
+}
+func (UnimplementedMutexServer) SampleWorkflowWithMutex(context.Context, *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleWorkflowWithMutex not implemented")
+}
+func (UnimplementedMutexServer) AcquireLease(context.Context, *AcquireLeaseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLease not implemented")
+}
+func (UnimplementedMutexServer) LeaseAcquired(context.Context, *LeaseAcquiredRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaseAcquired not implemented")
+}
+func (UnimplementedMutexServer) RenewLease(context.Context, *RenewLeaseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLease not implemented")
+}
+func (UnimplementedMutexServer) RevokeLease(context.Context, *RevokeLeaseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeLease not implemented")
+}
+func (UnimplementedMutexServer) mustEmbedUnimplementedMutexServer() {}
+
+// UnsafeMutexServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MutexServer will
+// result in compilation errors.
+type UnsafeMutexServer interface {
+	mustEmbedUnimplementedMutexServer()
+}
+
+func RegisterMutexServer(s grpc.ServiceRegistrar, srv MutexServer) {
+	s.RegisterService(&Mutex_ServiceDesc, srv)
+}
+
+func _Mutex_Mutex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MutexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).Mutex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_Mutex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).Mutex(ctx, req.(*MutexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mutex_SampleWorkflowWithMutex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleWorkflowWithMutexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).SampleWorkflowWithMutex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_SampleWorkflowWithMutex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).SampleWorkflowWithMutex(ctx, req.(*SampleWorkflowWithMutexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mutex_AcquireLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).AcquireLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_AcquireLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).AcquireLease(ctx, req.(*AcquireLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mutex_LeaseAcquired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseAcquiredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).LeaseAcquired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_LeaseAcquired_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).LeaseAcquired(ctx, req.(*LeaseAcquiredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mutex_RenewLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).RenewLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_RenewLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).RenewLease(ctx, req.(*RenewLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Mutex_RevokeLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeLeaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MutexServer).RevokeLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Mutex_RevokeLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MutexServer).RevokeLease(ctx, req.(*RevokeLeaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Mutex_ServiceDesc is the grpc.ServiceDesc for Mutex service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Mutex_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mycompany.mutex.v1.Mutex",
+	HandlerType: (*MutexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Mutex",
+			Handler:    _Mutex_Mutex_Handler,
+		},
+		{
+			MethodName: "SampleWorkflowWithMutex",
+			Handler:    _Mutex_SampleWorkflowWithMutex_Handler,
+		},
+		{
+			MethodName: "AcquireLease",
+			Handler:    _Mutex_AcquireLease_Handler,
+		},
+		{
+			MethodName: "LeaseAcquired",
+			Handler:    _Mutex_LeaseAcquired_Handler,
+		},
+		{
+			MethodName: "RenewLease",
+			Handler:    _Mutex_RenewLease_Handler,
+		},
+		{
+			MethodName: "RevokeLease",
+			Handler:    _Mutex_RevokeLease_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example.proto",
+}
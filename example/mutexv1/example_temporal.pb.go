@@ -13,11 +13,15 @@ import (
 	"errors"
 	"fmt"
 	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	grpcutil "github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	replayutil "github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
 	v1 "go.temporal.io/api/enums/v1"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // MutexTaskQueue is the default task-queue for a Mutex worker
@@ -112,9 +116,6 @@ func (c *workflowClient) ExecuteMutex(ctx context.Context, opts *client.StartWor
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "mutex-v1"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
 		if err != nil {
@@ -122,11 +123,14 @@ func (c *workflowClient) ExecuteMutex(ctx context.Context, opts *client.StartWor
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
+	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 	}
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, MutexWorkflowName, req)
 	if err != nil {
@@ -154,9 +158,6 @@ func (c *workflowClient) StartMutexWithAcquireLease(ctx context.Context, opts *c
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "mutex-v1"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
 		if err != nil {
@@ -164,11 +165,14 @@ func (c *workflowClient) StartMutexWithAcquireLease(ctx context.Context, opts *c
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
+	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 	}
 	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, AcquireLeaseSignalName, signal, *opts, MutexWorkflowName, req)
 	if run == nil || err != nil {
@@ -195,9 +199,6 @@ func (c *workflowClient) ExecuteSampleWorkflowWithMutex(ctx context.Context, opt
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "mutex-v1"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(SampleWorkflowWithMutexIDExpression, req.ProtoReflect())
 		if err != nil {
@@ -205,11 +206,14 @@ func (c *workflowClient) ExecuteSampleWorkflowWithMutex(ctx context.Context, opt
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
+	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 	}
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SampleWorkflowWithMutexWorkflowName, req)
 	if err != nil {
@@ -360,13 +364,27 @@ func RegisterWorkflows(r worker.Registry, workflows Workflows) {
 	RegisterSampleWorkflowWithMutexWorkflow(r, workflows.SampleWorkflowWithMutex)
 }
 
+// NewMutexReplayer initializes a new workflow replayer with all Mutex workflows registered
+func NewMutexReplayer(workflows Workflows) worker.WorkflowReplayer {
+	r := worker.NewWorkflowReplayer()
+	r.RegisterWorkflowWithOptions(buildMutex(workflows.Mutex), workflow.RegisterOptions{Name: MutexWorkflowName})
+	r.RegisterWorkflowWithOptions(buildSampleWorkflowWithMutex(workflows.SampleWorkflowWithMutex), workflow.RegisterOptions{Name: SampleWorkflowWithMutexWorkflowName})
+	return r
+}
+
+// ReplayMutexHistories replays every JSON workflow history in the given directory against the
+// given Mutex workflows, returning an error if any history fails to replay
+func ReplayMutexHistories(workflows Workflows, dir string) error {
+	return replayutil.ReplayDir(NewMutexReplayer(workflows), nil, dir)
+}
+
 // RegisterMutexWorkflow registers a Mutex workflow with the given worker
 func RegisterMutexWorkflow(r worker.Registry, wf func(workflow.Context, *MutexInput) (MutexWorkflow, error)) {
 	r.RegisterWorkflowWithOptions(buildMutex(wf), workflow.RegisterOptions{Name: MutexWorkflowName})
 }
 
 // buildMutex converts a Mutex workflow struct into a valid workflow function
-func buildMutex(wf func(workflow.Context, *MutexInput) (MutexWorkflow, error)) func(workflow.Context, *MutexRequest) error {
+func buildMutex(wf func(workflow.Context, *MutexInput) (MutexWorkflow, error)) func(workflow.Context, *MutexRequest, map[string][]*anypb.Any) error {
 	return (&mutex{wf}).Mutex
 }
 
@@ -376,7 +394,7 @@ type mutex struct {
 }
 
 // Mutex constructs a new Mutex value and executes it
-func (w *mutex) Mutex(ctx workflow.Context, req *MutexRequest) error {
+func (w *mutex) Mutex(ctx workflow.Context, req *MutexRequest, carried map[string][]*anypb.Any) error {
 	input := &MutexInput{
 		Req: req,
 		AcquireLease: &AcquireLeaseSignal{
@@ -389,11 +407,35 @@ func (w *mutex) Mutex(ctx workflow.Context, req *MutexRequest) error {
 			Channel: workflow.GetSignalChannel(ctx, RevokeLeaseSignalName),
 		},
 	}
+	if err := input.AcquireLease.restore(ctx, carried[AcquireLeaseSignalName]); err != nil {
+		return err
+	}
+	if err := input.RenewLease.restore(ctx, carried[RenewLeaseSignalName]); err != nil {
+		return err
+	}
+	if err := input.RevokeLease.restore(ctx, carried[RevokeLeaseSignalName]); err != nil {
+		return err
+	}
 	wf, err := w.ctor(ctx, input)
 	if err != nil {
 		return err
 	}
-	return wf.Execute(ctx)
+	err = wf.Execute(ctx)
+	if !errors.Is(err, errMutexContinueAsNew) {
+		return err
+	}
+	carried = make(map[string][]*anypb.Any)
+	if carried[AcquireLeaseSignalName], err = input.AcquireLease.drain(ctx); err != nil {
+		return err
+	}
+	if carried[RenewLeaseSignalName], err = input.RenewLease.drain(ctx); err != nil {
+		return err
+	}
+	if carried[RevokeLeaseSignalName], err = input.RevokeLease.drain(ctx); err != nil {
+		return err
+	}
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "mutex-v1")
+	return workflow.NewContinueAsNewError(ctx, MutexWorkflowName, input.Req, carried)
 }
 
 // MutexInput describes the input to a Mutex workflow constructor
@@ -404,6 +446,20 @@ type MutexInput struct {
 	RevokeLease  *RevokeLeaseSignal
 }
 
+// errMutexContinueAsNew is returned by MutexInput.ContinueAsNew to request that the workflow continue as new
+var errMutexContinueAsNew = errors.New("continue as new")
+
+// ShouldContinueAsNew returns true once the Mutex workflow history reaches 10000 events
+func (i *MutexInput) ShouldContinueAsNew(ctx workflow.Context) bool {
+	return workflow.GetInfo(ctx).GetCurrentHistoryLength() >= 10000
+}
+
+// ContinueAsNew returns an error that, when returned by Execute, continues the workflow as new
+// with the current Req, carrying over any pending signals
+func (i *MutexInput) ContinueAsNew() error {
+	return errMutexContinueAsNew
+}
+
 // Mutex provides a mutex over a shared resource
 type MutexWorkflow interface {
 	// Execute a Mutex workflow
@@ -414,23 +470,45 @@ type MutexWorkflow interface {
 func MutexChild(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *MutexRequest) *MutexChildRun {
 	if opts == nil {
 		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "mutex-v1"
+		childOpts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 		opts = &childOpts
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "mutex-v1"
-	}
 	if opts.WorkflowID == "" {
-		id, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
-		if err != nil {
-			panic(err)
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
+			if err != nil {
+				return &MutexChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &MutexChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &MutexChildRun{Future: newFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
 		}
-		opts.WorkflowID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
 	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 	}
 	ctx = workflow.WithChildOptions(ctx, *opts)
 	return &MutexChildRun{Future: workflow.ExecuteChildWorkflow(ctx, MutexWorkflowName, req)}
@@ -491,6 +569,13 @@ func (r *MutexChildRun) RevokeLease(ctx workflow.Context, input *RevokeLeaseRequ
 	return r.Future.SignalChildWorkflow(ctx, RevokeLeaseSignalName, input)
 }
 
+// MutexContinueAsNew returns an error that instructs the current workflow to continue as a new Mutex workflow,
+// applying the default task queue and timeouts
+func MutexContinueAsNew(ctx workflow.Context, req *MutexRequest) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "mutex-v1")
+	return workflow.NewContinueAsNewError(ctx, MutexWorkflowName, req)
+}
+
 // RegisterSampleWorkflowWithMutexWorkflow registers a SampleWorkflowWithMutex workflow with the given worker
 func RegisterSampleWorkflowWithMutexWorkflow(r worker.Registry, wf func(workflow.Context, *SampleWorkflowWithMutexInput) (SampleWorkflowWithMutexWorkflow, error)) {
 	r.RegisterWorkflowWithOptions(buildSampleWorkflowWithMutex(wf), workflow.RegisterOptions{Name: SampleWorkflowWithMutexWorkflowName})
@@ -538,23 +623,45 @@ type SampleWorkflowWithMutexWorkflow interface {
 func SampleWorkflowWithMutexChild(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *SampleWorkflowWithMutexRequest) *SampleWorkflowWithMutexChildRun {
 	if opts == nil {
 		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "mutex-v1"
+		childOpts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 		opts = &childOpts
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "mutex-v1"
-	}
 	if opts.WorkflowID == "" {
-		id, err := expression.EvalExpression(SampleWorkflowWithMutexIDExpression, req.ProtoReflect())
-		if err != nil {
-			panic(err)
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(SampleWorkflowWithMutexIDExpression, req.ProtoReflect())
+			if err != nil {
+				return &SampleWorkflowWithMutexChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(SampleWorkflowWithMutexIDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &SampleWorkflowWithMutexChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &SampleWorkflowWithMutexChildRun{Future: newFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
 		}
-		opts.WorkflowID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
 	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
 	}
 	ctx = workflow.WithChildOptions(ctx, *opts)
 	return &SampleWorkflowWithMutexChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SampleWorkflowWithMutexWorkflowName, req)}
@@ -606,6 +713,35 @@ func (r *SampleWorkflowWithMutexChildRun) LeaseAcquired(ctx workflow.Context, in
 	return r.Future.SignalChildWorkflow(ctx, LeaseAcquiredSignalName, input)
 }
 
+// SampleWorkflowWithMutexContinueAsNew returns an error that instructs the current workflow to continue as a new SampleWorkflowWithMutex workflow,
+// applying the default task queue and timeouts
+func SampleWorkflowWithMutexContinueAsNew(ctx workflow.Context, req *SampleWorkflowWithMutexRequest) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "mutex-v1")
+	return workflow.NewContinueAsNewError(ctx, SampleWorkflowWithMutexWorkflowName, req)
+}
+
+// failedChildFuture describes a child workflow future that failed before the child workflow was started
+type failedChildFuture struct {
+	workflow.Future
+}
+
+// newFailedChildFuture returns a child workflow future that has already failed with the given error
+func newFailedChildFuture(ctx workflow.Context, err error) *failedChildFuture {
+	future, settable := workflow.NewFuture(ctx)
+	settable.SetError(err)
+	return &failedChildFuture{Future: future}
+}
+
+// GetChildWorkflowExecution returns the failed future
+func (f *failedChildFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+// SignalChildWorkflow returns the failed future
+func (f *failedChildFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
 // AcquireLeaseSignal describes a AcquireLease signal
 type AcquireLeaseSignal struct {
 	Channel workflow.ReceiveChannel
@@ -642,6 +778,51 @@ func AcquireLeaseExternal(ctx workflow.Context, workflowID string, runID string,
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, AcquireLeaseSignalName, req)
 }
 
+// restore delivers AcquireLease signals carried over from a previous run ahead of any newly received signals
+func (s *AcquireLeaseSignal) restore(ctx workflow.Context, carried []*anypb.Any) error {
+	if len(carried) == 0 {
+		return nil
+	}
+	ch := workflow.NewBufferedChannel(ctx, len(carried))
+	for _, v := range carried {
+		var req AcquireLeaseRequest
+		if err := v.UnmarshalTo(&req); err != nil {
+			return fmt.Errorf("error restoring %s signal: %w", AcquireLeaseSignalName, err)
+		}
+		ch.SendAsync(&req)
+	}
+	signals := s.Channel
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var req AcquireLeaseRequest
+			signals.Receive(ctx, &req)
+			ch.Send(ctx, &req)
+		}
+	})
+	s.Channel = ch
+	return nil
+}
+
+// drain receives all pending AcquireLease signals without blocking so that they can be carried over to a new run
+func (s *AcquireLeaseSignal) drain(ctx workflow.Context) ([]*anypb.Any, error) {
+	var carried []*anypb.Any
+	// restored signals may still be buffered on the underlying signal channel
+	for _, ch := range []workflow.ReceiveChannel{s.Channel, workflow.GetSignalChannel(ctx, AcquireLeaseSignalName)} {
+		for {
+			var req AcquireLeaseRequest
+			if !ch.ReceiveAsync(&req) {
+				break
+			}
+			v, err := anypb.New(&req)
+			if err != nil {
+				return nil, fmt.Errorf("error draining %s signal: %w", AcquireLeaseSignalName, err)
+			}
+			carried = append(carried, v)
+		}
+	}
+	return carried, nil
+}
+
 // LeaseAcquiredSignal describes a LeaseAcquired signal
 type LeaseAcquiredSignal struct {
 	Channel workflow.ReceiveChannel
@@ -714,6 +895,51 @@ func RenewLeaseExternal(ctx workflow.Context, workflowID string, runID string, r
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, RenewLeaseSignalName, req)
 }
 
+// restore delivers RenewLease signals carried over from a previous run ahead of any newly received signals
+func (s *RenewLeaseSignal) restore(ctx workflow.Context, carried []*anypb.Any) error {
+	if len(carried) == 0 {
+		return nil
+	}
+	ch := workflow.NewBufferedChannel(ctx, len(carried))
+	for _, v := range carried {
+		var req RenewLeaseRequest
+		if err := v.UnmarshalTo(&req); err != nil {
+			return fmt.Errorf("error restoring %s signal: %w", RenewLeaseSignalName, err)
+		}
+		ch.SendAsync(&req)
+	}
+	signals := s.Channel
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var req RenewLeaseRequest
+			signals.Receive(ctx, &req)
+			ch.Send(ctx, &req)
+		}
+	})
+	s.Channel = ch
+	return nil
+}
+
+// drain receives all pending RenewLease signals without blocking so that they can be carried over to a new run
+func (s *RenewLeaseSignal) drain(ctx workflow.Context) ([]*anypb.Any, error) {
+	var carried []*anypb.Any
+	// restored signals may still be buffered on the underlying signal channel
+	for _, ch := range []workflow.ReceiveChannel{s.Channel, workflow.GetSignalChannel(ctx, RenewLeaseSignalName)} {
+		for {
+			var req RenewLeaseRequest
+			if !ch.ReceiveAsync(&req) {
+				break
+			}
+			v, err := anypb.New(&req)
+			if err != nil {
+				return nil, fmt.Errorf("error draining %s signal: %w", RenewLeaseSignalName, err)
+			}
+			carried = append(carried, v)
+		}
+	}
+	return carried, nil
+}
+
 // RevokeLeaseSignal describes a RevokeLease signal
 type RevokeLeaseSignal struct {
 	Channel workflow.ReceiveChannel
@@ -750,6 +976,51 @@ func RevokeLeaseExternal(ctx workflow.Context, workflowID string, runID string,
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, RevokeLeaseSignalName, req)
 }
 
+// restore delivers RevokeLease signals carried over from a previous run ahead of any newly received signals
+func (s *RevokeLeaseSignal) restore(ctx workflow.Context, carried []*anypb.Any) error {
+	if len(carried) == 0 {
+		return nil
+	}
+	ch := workflow.NewBufferedChannel(ctx, len(carried))
+	for _, v := range carried {
+		var req RevokeLeaseRequest
+		if err := v.UnmarshalTo(&req); err != nil {
+			return fmt.Errorf("error restoring %s signal: %w", RevokeLeaseSignalName, err)
+		}
+		ch.SendAsync(&req)
+	}
+	signals := s.Channel
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var req RevokeLeaseRequest
+			signals.Receive(ctx, &req)
+			ch.Send(ctx, &req)
+		}
+	})
+	s.Channel = ch
+	return nil
+}
+
+// drain receives all pending RevokeLease signals without blocking so that they can be carried over to a new run
+func (s *RevokeLeaseSignal) drain(ctx workflow.Context) ([]*anypb.Any, error) {
+	var carried []*anypb.Any
+	// restored signals may still be buffered on the underlying signal channel
+	for _, ch := range []workflow.ReceiveChannel{s.Channel, workflow.GetSignalChannel(ctx, RevokeLeaseSignalName)} {
+		for {
+			var req RevokeLeaseRequest
+			if !ch.ReceiveAsync(&req) {
+				break
+			}
+			v, err := anypb.New(&req)
+			if err != nil {
+				return nil, fmt.Errorf("error draining %s signal: %w", RevokeLeaseSignalName, err)
+			}
+			carried = append(carried, v)
+		}
+	}
+	return carried, nil
+}
+
 // Activities describes available worker activites
 type Activities interface {
 	// Mutex provides a mutex over a shared resource
@@ -812,3 +1083,105 @@ func MutexLocal(ctx workflow.Context, opts *workflow.LocalActivityOptions, fn fu
 	}
 	return &MutexFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
 }
+
+// Compile-time check that workflowServer satisfies MutexServer
+var _ MutexServer = &workflowServer{}
+
+// workflowServer implements a MutexServer that forwards requests to a Mutex client
+type workflowServer struct {
+	UnimplementedMutexServer
+	client Client
+}
+
+// NewServer initializes a new MutexServer that forwards requests to the given Mutex client. Target
+// workflow and run ids are read from incoming gRPC metadata, falling back to the default id
+// expression when starting workflows
+func NewServer(c Client) MutexServer {
+	return &workflowServer{client: c}
+}
+
+// Mutex provides a mutex over a shared resource
+func (s *workflowServer) Mutex(ctx context.Context, req *MutexRequest) (*emptypb.Empty, error) {
+	if err := s.client.Mutex(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SampleWorkflowWithMutex provides an example of a running workflow that uses
+// a Mutex workflow to prevent concurrent access to a shared resource
+func (s *workflowServer) SampleWorkflowWithMutex(ctx context.Context, req *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error) {
+	resp, err := s.client.SampleWorkflowWithMutex(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// AcquireLease enqueues a lease on the given resource
+func (s *workflowServer) AcquireLease(ctx context.Context, req *AcquireLeaseRequest) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalAcquireLease(ctx, workflowID, grpcutil.RunID(ctx), req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// LeaseAcquired notifies the calling workflow that a lease has been required
+func (s *workflowServer) LeaseAcquired(ctx context.Context, req *LeaseAcquiredRequest) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalLeaseAcquired(ctx, workflowID, grpcutil.RunID(ctx), req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RenewLease extends the validity of an existing lease
+func (s *workflowServer) RenewLease(ctx context.Context, req *RenewLeaseRequest) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalRenewLease(ctx, workflowID, grpcutil.RunID(ctx), req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// RevokeLease revokes an existing lease
+func (s *workflowServer) RevokeLease(ctx context.Context, req *RevokeLeaseRequest) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalRevokeLease(ctx, workflowID, grpcutil.RunID(ctx), req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Compile-time check that serverActivities satisfies Activities
+var _ Activities = &serverActivities{}
+
+// serverActivities implements Activities by calling a MutexServer
+type serverActivities struct {
+	server MutexServer
+}
+
+// NewActivitiesFromServer initializes a new Activities value that calls the given MutexServer,
+// converting gRPC status errors into temporal application errors
+func NewActivitiesFromServer(srv MutexServer) Activities {
+	return &serverActivities{server: srv}
+}
+
+// Mutex calls the MutexServer's Mutex method
+func (a *serverActivities) Mutex(ctx context.Context, req *MutexRequest) error {
+	_, err := a.server.Mutex(ctx, req)
+	return grpcutil.ToApplicationError(err)
+}
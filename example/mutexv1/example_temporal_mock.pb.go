@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: example.proto
+package mutexv1
+
+import (
+	"context"
+	mock "github.com/stretchr/testify/mock"
+	client "go.temporal.io/sdk/client"
+)
+
+// Compile-time check that MockClient satisfies Client
+var _ Client = &MockClient{}
+
+// MockClient is a testify mock implementation of Client
+type MockClient struct {
+	mock.Mock
+}
+
+// NewMockClient initializes a new MockClient that asserts its expectations when the test completes
+func NewMockClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClient {
+	m := &MockClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// Mutex implements Client.Mutex
+func (m *MockClient) Mutex(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest) error {
+	args := m.Called(ctx, opts, req)
+	return args.Error(0)
+}
+
+// OnMutex registers an expectation for a Mutex call
+func (m *MockClient) OnMutex(ctx any, opts any, req any) *MockClientMutexCall {
+	return &MockClientMutexCall{Call: m.On("Mutex", ctx, opts, req)}
+}
+
+// MockClientMutexCall wraps a Mutex expectation with typed return values
+type MockClientMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Mutex call
+func (c *MockClientMutexCall) Return(err error) *MockClientMutexCall {
+	c.Call.Return(err)
+	return c
+}
+
+// ExecuteMutex implements Client.ExecuteMutex
+func (m *MockClient) ExecuteMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest) (MutexRun, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 MutexRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(MutexRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteMutex registers an expectation for a ExecuteMutex call
+func (m *MockClient) OnExecuteMutex(ctx any, opts any, req any) *MockClientExecuteMutexCall {
+	return &MockClientExecuteMutexCall{Call: m.On("ExecuteMutex", ctx, opts, req)}
+}
+
+// MockClientExecuteMutexCall wraps a ExecuteMutex expectation with typed return values
+type MockClientExecuteMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteMutex call
+func (c *MockClientExecuteMutexCall) Return(r0 MutexRun, err error) *MockClientExecuteMutexCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetMutex implements Client.GetMutex
+func (m *MockClient) GetMutex(ctx context.Context, workflowID string, runID string) (MutexRun, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 MutexRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(MutexRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetMutex registers an expectation for a GetMutex call
+func (m *MockClient) OnGetMutex(ctx any, workflowID any, runID any) *MockClientGetMutexCall {
+	return &MockClientGetMutexCall{Call: m.On("GetMutex", ctx, workflowID, runID)}
+}
+
+// MockClientGetMutexCall wraps a GetMutex expectation with typed return values
+type MockClientGetMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetMutex call
+func (c *MockClientGetMutexCall) Return(r0 MutexRun, err error) *MockClientGetMutexCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// StartMutexWithAcquireLease implements Client.StartMutexWithAcquireLease
+func (m *MockClient) StartMutexWithAcquireLease(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest, signal *AcquireLeaseRequest) (MutexRun, error) {
+	args := m.Called(ctx, opts, req, signal)
+	var r0 MutexRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(MutexRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnStartMutexWithAcquireLease registers an expectation for a StartMutexWithAcquireLease call
+func (m *MockClient) OnStartMutexWithAcquireLease(ctx any, opts any, req any, signal any) *MockClientStartMutexWithAcquireLeaseCall {
+	return &MockClientStartMutexWithAcquireLeaseCall{Call: m.On("StartMutexWithAcquireLease", ctx, opts, req, signal)}
+}
+
+// MockClientStartMutexWithAcquireLeaseCall wraps a StartMutexWithAcquireLease expectation with typed return values
+type MockClientStartMutexWithAcquireLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a StartMutexWithAcquireLease call
+func (c *MockClientStartMutexWithAcquireLeaseCall) Return(r0 MutexRun, err error) *MockClientStartMutexWithAcquireLeaseCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SampleWorkflowWithMutex implements Client.SampleWorkflowWithMutex
+func (m *MockClient) SampleWorkflowWithMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 *SampleWorkflowWithMutexResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SampleWorkflowWithMutexResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnSampleWorkflowWithMutex registers an expectation for a SampleWorkflowWithMutex call
+func (m *MockClient) OnSampleWorkflowWithMutex(ctx any, opts any, req any) *MockClientSampleWorkflowWithMutexCall {
+	return &MockClientSampleWorkflowWithMutexCall{Call: m.On("SampleWorkflowWithMutex", ctx, opts, req)}
+}
+
+// MockClientSampleWorkflowWithMutexCall wraps a SampleWorkflowWithMutex expectation with typed return values
+type MockClientSampleWorkflowWithMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SampleWorkflowWithMutex call
+func (c *MockClientSampleWorkflowWithMutexCall) Return(r0 *SampleWorkflowWithMutexResponse, err error) *MockClientSampleWorkflowWithMutexCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// ExecuteSampleWorkflowWithMutex implements Client.ExecuteSampleWorkflowWithMutex
+func (m *MockClient) ExecuteSampleWorkflowWithMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *SampleWorkflowWithMutexRequest) (SampleWorkflowWithMutexRun, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 SampleWorkflowWithMutexRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(SampleWorkflowWithMutexRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteSampleWorkflowWithMutex registers an expectation for a ExecuteSampleWorkflowWithMutex call
+func (m *MockClient) OnExecuteSampleWorkflowWithMutex(ctx any, opts any, req any) *MockClientExecuteSampleWorkflowWithMutexCall {
+	return &MockClientExecuteSampleWorkflowWithMutexCall{Call: m.On("ExecuteSampleWorkflowWithMutex", ctx, opts, req)}
+}
+
+// MockClientExecuteSampleWorkflowWithMutexCall wraps a ExecuteSampleWorkflowWithMutex expectation with typed return values
+type MockClientExecuteSampleWorkflowWithMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteSampleWorkflowWithMutex call
+func (c *MockClientExecuteSampleWorkflowWithMutexCall) Return(r0 SampleWorkflowWithMutexRun, err error) *MockClientExecuteSampleWorkflowWithMutexCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetSampleWorkflowWithMutex implements Client.GetSampleWorkflowWithMutex
+func (m *MockClient) GetSampleWorkflowWithMutex(ctx context.Context, workflowID string, runID string) (SampleWorkflowWithMutexRun, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SampleWorkflowWithMutexRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(SampleWorkflowWithMutexRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetSampleWorkflowWithMutex registers an expectation for a GetSampleWorkflowWithMutex call
+func (m *MockClient) OnGetSampleWorkflowWithMutex(ctx any, workflowID any, runID any) *MockClientGetSampleWorkflowWithMutexCall {
+	return &MockClientGetSampleWorkflowWithMutexCall{Call: m.On("GetSampleWorkflowWithMutex", ctx, workflowID, runID)}
+}
+
+// MockClientGetSampleWorkflowWithMutexCall wraps a GetSampleWorkflowWithMutex expectation with typed return values
+type MockClientGetSampleWorkflowWithMutexCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetSampleWorkflowWithMutex call
+func (c *MockClientGetSampleWorkflowWithMutexCall) Return(r0 SampleWorkflowWithMutexRun, err error) *MockClientGetSampleWorkflowWithMutexCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SignalAcquireLease implements Client.SignalAcquireLease
+func (m *MockClient) SignalAcquireLease(ctx context.Context, workflowID string, runID string, signal *AcquireLeaseRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	return args.Error(0)
+}
+
+// OnSignalAcquireLease registers an expectation for a SignalAcquireLease call
+func (m *MockClient) OnSignalAcquireLease(ctx any, workflowID any, runID any, signal any) *MockClientSignalAcquireLeaseCall {
+	return &MockClientSignalAcquireLeaseCall{Call: m.On("SignalAcquireLease", ctx, workflowID, runID, signal)}
+}
+
+// MockClientSignalAcquireLeaseCall wraps a SignalAcquireLease expectation with typed return values
+type MockClientSignalAcquireLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalAcquireLease call
+func (c *MockClientSignalAcquireLeaseCall) Return(err error) *MockClientSignalAcquireLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SignalLeaseAcquired implements Client.SignalLeaseAcquired
+func (m *MockClient) SignalLeaseAcquired(ctx context.Context, workflowID string, runID string, signal *LeaseAcquiredRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	return args.Error(0)
+}
+
+// OnSignalLeaseAcquired registers an expectation for a SignalLeaseAcquired call
+func (m *MockClient) OnSignalLeaseAcquired(ctx any, workflowID any, runID any, signal any) *MockClientSignalLeaseAcquiredCall {
+	return &MockClientSignalLeaseAcquiredCall{Call: m.On("SignalLeaseAcquired", ctx, workflowID, runID, signal)}
+}
+
+// MockClientSignalLeaseAcquiredCall wraps a SignalLeaseAcquired expectation with typed return values
+type MockClientSignalLeaseAcquiredCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalLeaseAcquired call
+func (c *MockClientSignalLeaseAcquiredCall) Return(err error) *MockClientSignalLeaseAcquiredCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SignalRenewLease implements Client.SignalRenewLease
+func (m *MockClient) SignalRenewLease(ctx context.Context, workflowID string, runID string, signal *RenewLeaseRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	return args.Error(0)
+}
+
+// OnSignalRenewLease registers an expectation for a SignalRenewLease call
+func (m *MockClient) OnSignalRenewLease(ctx any, workflowID any, runID any, signal any) *MockClientSignalRenewLeaseCall {
+	return &MockClientSignalRenewLeaseCall{Call: m.On("SignalRenewLease", ctx, workflowID, runID, signal)}
+}
+
+// MockClientSignalRenewLeaseCall wraps a SignalRenewLease expectation with typed return values
+type MockClientSignalRenewLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalRenewLease call
+func (c *MockClientSignalRenewLeaseCall) Return(err error) *MockClientSignalRenewLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SignalRevokeLease implements Client.SignalRevokeLease
+func (m *MockClient) SignalRevokeLease(ctx context.Context, workflowID string, runID string, signal *RevokeLeaseRequest) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	return args.Error(0)
+}
+
+// OnSignalRevokeLease registers an expectation for a SignalRevokeLease call
+func (m *MockClient) OnSignalRevokeLease(ctx any, workflowID any, runID any, signal any) *MockClientSignalRevokeLeaseCall {
+	return &MockClientSignalRevokeLeaseCall{Call: m.On("SignalRevokeLease", ctx, workflowID, runID, signal)}
+}
+
+// MockClientSignalRevokeLeaseCall wraps a SignalRevokeLease expectation with typed return values
+type MockClientSignalRevokeLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalRevokeLease call
+func (c *MockClientSignalRevokeLeaseCall) Return(err error) *MockClientSignalRevokeLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// Compile-time check that MockMutexRun satisfies MutexRun
+var _ MutexRun = &MockMutexRun{}
+
+// MockMutexRun is a testify mock implementation of MutexRun
+type MockMutexRun struct {
+	mock.Mock
+}
+
+// NewMockMutexRun initializes a new MockMutexRun that asserts its expectations when the test completes
+func NewMockMutexRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMutexRun {
+	m := &MockMutexRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements MutexRun.ID
+func (m *MockMutexRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockMutexRun) OnID() *MockMutexRunIDCall {
+	return &MockMutexRunIDCall{Call: m.On("ID")}
+}
+
+// MockMutexRunIDCall wraps a ID expectation with typed return values
+type MockMutexRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockMutexRunIDCall) Return(r0 string) *MockMutexRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements MutexRun.RunID
+func (m *MockMutexRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockMutexRun) OnRunID() *MockMutexRunRunIDCall {
+	return &MockMutexRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockMutexRunRunIDCall wraps a RunID expectation with typed return values
+type MockMutexRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockMutexRunRunIDCall) Return(r0 string) *MockMutexRunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements MutexRun.Get
+func (m *MockMutexRun) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockMutexRun) OnGet(ctx any) *MockMutexRunGetCall {
+	return &MockMutexRunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockMutexRunGetCall wraps a Get expectation with typed return values
+type MockMutexRunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockMutexRunGetCall) Return(err error) *MockMutexRunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// AcquireLease implements MutexRun.AcquireLease
+func (m *MockMutexRun) AcquireLease(ctx context.Context, req *AcquireLeaseRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnAcquireLease registers an expectation for a AcquireLease call
+func (m *MockMutexRun) OnAcquireLease(ctx any, req any) *MockMutexRunAcquireLeaseCall {
+	return &MockMutexRunAcquireLeaseCall{Call: m.On("AcquireLease", ctx, req)}
+}
+
+// MockMutexRunAcquireLeaseCall wraps a AcquireLease expectation with typed return values
+type MockMutexRunAcquireLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a AcquireLease call
+func (c *MockMutexRunAcquireLeaseCall) Return(err error) *MockMutexRunAcquireLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// RenewLease implements MutexRun.RenewLease
+func (m *MockMutexRun) RenewLease(ctx context.Context, req *RenewLeaseRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnRenewLease registers an expectation for a RenewLease call
+func (m *MockMutexRun) OnRenewLease(ctx any, req any) *MockMutexRunRenewLeaseCall {
+	return &MockMutexRunRenewLeaseCall{Call: m.On("RenewLease", ctx, req)}
+}
+
+// MockMutexRunRenewLeaseCall wraps a RenewLease expectation with typed return values
+type MockMutexRunRenewLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RenewLease call
+func (c *MockMutexRunRenewLeaseCall) Return(err error) *MockMutexRunRenewLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// RevokeLease implements MutexRun.RevokeLease
+func (m *MockMutexRun) RevokeLease(ctx context.Context, req *RevokeLeaseRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnRevokeLease registers an expectation for a RevokeLease call
+func (m *MockMutexRun) OnRevokeLease(ctx any, req any) *MockMutexRunRevokeLeaseCall {
+	return &MockMutexRunRevokeLeaseCall{Call: m.On("RevokeLease", ctx, req)}
+}
+
+// MockMutexRunRevokeLeaseCall wraps a RevokeLease expectation with typed return values
+type MockMutexRunRevokeLeaseCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RevokeLease call
+func (c *MockMutexRunRevokeLeaseCall) Return(err error) *MockMutexRunRevokeLeaseCall {
+	c.Call.Return(err)
+	return c
+}
+
+// Compile-time check that MockSampleWorkflowWithMutexRun satisfies SampleWorkflowWithMutexRun
+var _ SampleWorkflowWithMutexRun = &MockSampleWorkflowWithMutexRun{}
+
+// MockSampleWorkflowWithMutexRun is a testify mock implementation of SampleWorkflowWithMutexRun
+type MockSampleWorkflowWithMutexRun struct {
+	mock.Mock
+}
+
+// NewMockSampleWorkflowWithMutexRun initializes a new MockSampleWorkflowWithMutexRun that asserts its expectations when the test completes
+func NewMockSampleWorkflowWithMutexRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSampleWorkflowWithMutexRun {
+	m := &MockSampleWorkflowWithMutexRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SampleWorkflowWithMutexRun.ID
+func (m *MockSampleWorkflowWithMutexRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockSampleWorkflowWithMutexRun) OnID() *MockSampleWorkflowWithMutexRunIDCall {
+	return &MockSampleWorkflowWithMutexRunIDCall{Call: m.On("ID")}
+}
+
+// MockSampleWorkflowWithMutexRunIDCall wraps a ID expectation with typed return values
+type MockSampleWorkflowWithMutexRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockSampleWorkflowWithMutexRunIDCall) Return(r0 string) *MockSampleWorkflowWithMutexRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements SampleWorkflowWithMutexRun.RunID
+func (m *MockSampleWorkflowWithMutexRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockSampleWorkflowWithMutexRun) OnRunID() *MockSampleWorkflowWithMutexRunRunIDCall {
+	return &MockSampleWorkflowWithMutexRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSampleWorkflowWithMutexRunRunIDCall wraps a RunID expectation with typed return values
+type MockSampleWorkflowWithMutexRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockSampleWorkflowWithMutexRunRunIDCall) Return(r0 string) *MockSampleWorkflowWithMutexRunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements SampleWorkflowWithMutexRun.Get
+func (m *MockSampleWorkflowWithMutexRun) Get(ctx context.Context) (*SampleWorkflowWithMutexResponse, error) {
+	args := m.Called(ctx)
+	var r0 *SampleWorkflowWithMutexResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SampleWorkflowWithMutexResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockSampleWorkflowWithMutexRun) OnGet(ctx any) *MockSampleWorkflowWithMutexRunGetCall {
+	return &MockSampleWorkflowWithMutexRunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSampleWorkflowWithMutexRunGetCall wraps a Get expectation with typed return values
+type MockSampleWorkflowWithMutexRunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockSampleWorkflowWithMutexRunGetCall) Return(r0 *SampleWorkflowWithMutexResponse, err error) *MockSampleWorkflowWithMutexRunGetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// LeaseAcquired implements SampleWorkflowWithMutexRun.LeaseAcquired
+func (m *MockSampleWorkflowWithMutexRun) LeaseAcquired(ctx context.Context, req *LeaseAcquiredRequest) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnLeaseAcquired registers an expectation for a LeaseAcquired call
+func (m *MockSampleWorkflowWithMutexRun) OnLeaseAcquired(ctx any, req any) *MockSampleWorkflowWithMutexRunLeaseAcquiredCall {
+	return &MockSampleWorkflowWithMutexRunLeaseAcquiredCall{Call: m.On("LeaseAcquired", ctx, req)}
+}
+
+// MockSampleWorkflowWithMutexRunLeaseAcquiredCall wraps a LeaseAcquired expectation with typed return values
+type MockSampleWorkflowWithMutexRunLeaseAcquiredCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a LeaseAcquired call
+func (c *MockSampleWorkflowWithMutexRunLeaseAcquiredCall) Return(err error) *MockSampleWorkflowWithMutexRunLeaseAcquiredCall {
+	c.Call.Return(err)
+	return c
+}
@@ -0,0 +1,426 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: example.proto
+package mutexv1
+
+import (
+	"context"
+	"fmt"
+	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	v1 "go.temporal.io/api/enums/v1"
+	serviceerror "go.temporal.io/api/serviceerror"
+	client "go.temporal.io/sdk/client"
+	testsuite "go.temporal.io/sdk/testsuite"
+	"sync"
+	"time"
+)
+
+// TestEnv wraps a testsuite.TestWorkflowEnvironment with typed helpers for Mutex workflows
+type TestEnv struct {
+	*testsuite.TestWorkflowEnvironment
+}
+
+// NewTestEnv initializes a new TestEnv that registers the given workflows with the test environment.
+// When activities is nil, placeholder activities are registered that must be mocked using the
+// On<Activity> helpers
+func NewTestEnv(env *testsuite.TestWorkflowEnvironment, workflows Workflows, activities Activities) *TestEnv {
+	RegisterWorkflows(env, workflows)
+	if activities == nil {
+		activities = &testEnvActivities{}
+	}
+	RegisterActivities(env, activities)
+	return &TestEnv{env}
+}
+
+// ExecuteMutex executes a Mutex workflow in the test environment and blocks until it completes
+func (e *TestEnv) ExecuteMutex(req *MutexRequest) error {
+	e.ExecuteWorkflow(MutexWorkflowName, req)
+	return e.GetWorkflowError()
+}
+
+// ExecuteSampleWorkflowWithMutex executes a SampleWorkflowWithMutex workflow in the test environment and blocks until it completes
+func (e *TestEnv) ExecuteSampleWorkflowWithMutex(req *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error) {
+	e.ExecuteWorkflow(SampleWorkflowWithMutexWorkflowName, req)
+	if err := e.GetWorkflowError(); err != nil {
+		return nil, err
+	}
+	var resp SampleWorkflowWithMutexResponse
+	if err := e.GetWorkflowResult(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SignalAcquireLeaseAfter sends a AcquireLease signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalAcquireLeaseAfter(d time.Duration, req *AcquireLeaseRequest) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(AcquireLeaseSignalName, req)
+	}, d)
+}
+
+// SignalLeaseAcquiredAfter sends a LeaseAcquired signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalLeaseAcquiredAfter(d time.Duration, req *LeaseAcquiredRequest) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(LeaseAcquiredSignalName, req)
+	}, d)
+}
+
+// SignalRenewLeaseAfter sends a RenewLease signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalRenewLeaseAfter(d time.Duration, req *RenewLeaseRequest) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(RenewLeaseSignalName, req)
+	}, d)
+}
+
+// SignalRevokeLeaseAfter sends a RevokeLease signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalRevokeLeaseAfter(d time.Duration, req *RevokeLeaseRequest) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(RevokeLeaseSignalName, req)
+	}, d)
+}
+
+// OnMutex mocks a Mutex activity execution in the test environment
+func (e *TestEnv) OnMutex(req any) *TestEnvMutexCall {
+	return &TestEnvMutexCall{e.OnActivity(MutexActivityName, mock.Anything, req)}
+}
+
+// TestEnvMutexCall wraps a Mutex activity mock with typed return values
+type TestEnvMutexCall struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked Mutex activity
+func (c *TestEnvMutexCall) Return(err error) *TestEnvMutexCall {
+	c.MockCallWrapper.Return(err)
+	return c
+}
+
+// testEnvActivities provides placeholder activities that must be mocked
+type testEnvActivities struct{}
+
+// Mutex returns an error indicating that the activity was not mocked
+func (a *testEnvActivities) Mutex(ctx context.Context, req *MutexRequest) error {
+	return fmt.Errorf("%s activity is not mocked", MutexActivityName)
+}
+
+// Compile-time check that TestClient satisfies Client
+var _ Client = &TestClient{}
+
+// TestClient is an in-memory Client implementation that executes workflows using a TestEnv.
+// Signals sent before a workflow is executed are delivered when it starts, while Get, queries,
+// and updates execute the workflow to completion on first use
+type TestClient struct {
+	newEnv func() *TestEnv
+	mu     sync.Mutex
+	runs   map[string]*testClientRun
+}
+
+// NewTestClient initializes a new TestClient that calls newEnv to create the TestEnv used by each
+// workflow execution
+func NewTestClient(newEnv func() *TestEnv) *TestClient {
+	return &TestClient{
+		newEnv: newEnv,
+		runs:   map[string]*testClientRun{},
+	}
+}
+
+// start initializes a new workflow execution using a new test environment
+func (c *TestClient) start(opts *client.StartWorkflowOptions, workflow string, args ...any) (*testClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if opts.ID == "" {
+		opts.ID = uuid.NewString()
+	}
+	if run, ok := c.runs[opts.ID]; ok {
+		run.mu.Lock()
+		executed := run.executed
+		run.mu.Unlock()
+		if !executed {
+			return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(fmt.Sprintf("workflow %s already started", opts.ID), "", run.runID)
+		}
+	}
+	env := c.newEnv()
+	env.SetStartWorkflowOptions(*opts)
+	run := &testClientRun{
+		args:     args,
+		env:      env,
+		id:       opts.ID,
+		runID:    uuid.NewString(),
+		workflow: workflow,
+	}
+	c.runs[opts.ID] = run
+	return run, nil
+}
+
+// run returns an existing workflow execution
+func (c *TestClient) run(workflowID string, runID string) (*testClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	run, ok := c.runs[workflowID]
+	if !ok || (runID != "" && runID != run.runID) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow %s not found", workflowID))
+	}
+	return run, nil
+}
+
+// Mutex executes a Mutex workflow in a new test environment and blocks until it completes
+func (c *TestClient) Mutex(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest) error {
+	run, err := c.ExecuteMutex(ctx, opts, req)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteMutex starts a Mutex workflow in a new test environment
+func (c *TestClient) ExecuteMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest) (MutexRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(MutexIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
+	}
+	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	run, err := c.start(opts, MutexWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testMutexRun{run}, nil
+}
+
+// GetMutex fetches an existing Mutex execution started by the test client
+func (c *TestClient) GetMutex(ctx context.Context, workflowID string, runID string) (MutexRun, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testMutexRun{run}, nil
+}
+
+// StartMutexWithAcquireLease starts a Mutex workflow and sends a AcquireLease signal that is delivered when it starts
+func (c *TestClient) StartMutexWithAcquireLease(ctx context.Context, opts *client.StartWorkflowOptions, req *MutexRequest, signal *AcquireLeaseRequest) (MutexRun, error) {
+	run, err := c.ExecuteMutex(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := run.AcquireLease(ctx, signal); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// SampleWorkflowWithMutex executes a SampleWorkflowWithMutex workflow in a new test environment and blocks until it completes
+func (c *TestClient) SampleWorkflowWithMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *SampleWorkflowWithMutexRequest) (*SampleWorkflowWithMutexResponse, error) {
+	run, err := c.ExecuteSampleWorkflowWithMutex(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteSampleWorkflowWithMutex starts a SampleWorkflowWithMutex workflow in a new test environment
+func (c *TestClient) ExecuteSampleWorkflowWithMutex(ctx context.Context, opts *client.StartWorkflowOptions, req *SampleWorkflowWithMutexRequest) (SampleWorkflowWithMutexRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SampleWorkflowWithMutexIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "mutex-v1"
+	}
+	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	run, err := c.start(opts, SampleWorkflowWithMutexWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testSampleWorkflowWithMutexRun{run}, nil
+}
+
+// GetSampleWorkflowWithMutex fetches an existing SampleWorkflowWithMutex execution started by the test client
+func (c *TestClient) GetSampleWorkflowWithMutex(ctx context.Context, workflowID string, runID string) (SampleWorkflowWithMutexRun, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testSampleWorkflowWithMutexRun{run}, nil
+}
+
+// SignalAcquireLease sends a AcquireLease signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalAcquireLease(ctx context.Context, workflowID string, runID string, signal *AcquireLeaseRequest) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(AcquireLeaseSignalName, signal)
+}
+
+// SignalLeaseAcquired sends a LeaseAcquired signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalLeaseAcquired(ctx context.Context, workflowID string, runID string, signal *LeaseAcquiredRequest) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(LeaseAcquiredSignalName, signal)
+}
+
+// SignalRenewLease sends a RenewLease signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalRenewLease(ctx context.Context, workflowID string, runID string, signal *RenewLeaseRequest) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(RenewLeaseSignalName, signal)
+}
+
+// SignalRevokeLease sends a RevokeLease signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalRevokeLease(ctx context.Context, workflowID string, runID string, signal *RevokeLeaseRequest) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(RevokeLeaseSignalName, signal)
+}
+
+// testClientRun describes a workflow execution started by a TestClient
+type testClientRun struct {
+	mu       sync.Mutex
+	env      *TestEnv
+	id       string
+	runID    string
+	workflow string
+	args     []any
+	executed bool
+}
+
+// execute runs the workflow to completion if it has not already been executed
+func (r *testClientRun) execute() {
+	if !r.executed {
+		r.executed = true
+		r.env.ExecuteWorkflow(r.workflow, r.args...)
+	}
+}
+
+// get executes the workflow and decodes its result into resp, if not nil
+func (r *testClientRun) get(resp any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.execute()
+	if err := r.env.GetWorkflowError(); err != nil || resp == nil {
+		return err
+	}
+	return r.env.GetWorkflowResult(resp)
+}
+
+// signal queues the named signal for delivery when the workflow starts
+func (r *testClientRun) signal(name string, arg any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.executed {
+		return serviceerror.NewNotFound(fmt.Sprintf("workflow %s already completed", r.id))
+	}
+	r.env.RegisterDelayedCallback(func() {
+		r.env.SignalWorkflow(name, arg)
+	}, 0)
+	return nil
+}
+
+// testMutexRun provides a MutexRun implementation for executions started by a TestClient
+type testMutexRun struct {
+	run *testClientRun
+}
+
+// ID returns the workflow ID
+func (r *testMutexRun) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testMutexRun) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testMutexRun) Get(ctx context.Context) error {
+	return r.run.get(nil)
+}
+
+// AcquireLease sends a AcquireLease signal that is delivered when the workflow starts
+func (r *testMutexRun) AcquireLease(ctx context.Context, req *AcquireLeaseRequest) error {
+	run := r.run
+	return run.signal(AcquireLeaseSignalName, req)
+}
+
+// RenewLease sends a RenewLease signal that is delivered when the workflow starts
+func (r *testMutexRun) RenewLease(ctx context.Context, req *RenewLeaseRequest) error {
+	run := r.run
+	return run.signal(RenewLeaseSignalName, req)
+}
+
+// RevokeLease sends a RevokeLease signal that is delivered when the workflow starts
+func (r *testMutexRun) RevokeLease(ctx context.Context, req *RevokeLeaseRequest) error {
+	run := r.run
+	return run.signal(RevokeLeaseSignalName, req)
+}
+
+// testSampleWorkflowWithMutexRun provides a SampleWorkflowWithMutexRun implementation for executions started by a TestClient
+type testSampleWorkflowWithMutexRun struct {
+	run *testClientRun
+}
+
+// ID returns the workflow ID
+func (r *testSampleWorkflowWithMutexRun) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testSampleWorkflowWithMutexRun) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testSampleWorkflowWithMutexRun) Get(ctx context.Context) (*SampleWorkflowWithMutexResponse, error) {
+	var resp SampleWorkflowWithMutexResponse
+	if err := r.run.get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// LeaseAcquired sends a LeaseAcquired signal that is delivered when the workflow starts
+func (r *testSampleWorkflowWithMutexRun) LeaseAcquired(ctx context.Context, req *LeaseAcquiredRequest) error {
+	run := r.run
+	return run.signal(LeaseAcquiredSignalName, req)
+}
@@ -0,0 +1,873 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: multiple/multiple.proto
+package multipletemporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	grpcutil "github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	replayutil "github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+)
+
+// GreeterTaskQueue is the default task-queue for a Greeter worker
+const GreeterTaskQueue = "greeter"
+
+// Greeter workflow names
+const (
+	GreetWorkflowName = "mycompany.multiple.Greet"
+)
+
+// Greeter id expressions
+var (
+	GreetIDExpression = expression.MustParseExpression("greet/${!name}")
+)
+
+// Greeter activity names
+const (
+	FormatGreetingActivityName = "mycompany.multiple.Greeter.FormatGreetingActivity"
+)
+
+// GreeterTemporalClient describes a client for a Greeter worker
+type GreeterTemporalClient interface {
+	// Greet greets someone.
+	Greet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (*multiple.GreetResponse, error)
+	// ExecuteGreet executes a Greet workflow
+	ExecuteGreet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (GreetRun, error)
+	// GetGreet retrieves a Greet workflow execution
+	GetGreet(ctx context.Context, workflowID string, runID string) (GreetRun, error)
+}
+
+// Compile-time check that greeterWorkflowClient satisfies GreeterTemporalClient
+var _ GreeterTemporalClient = &greeterWorkflowClient{}
+
+// greeterWorkflowClient implements a temporal client for a Greeter service
+type greeterWorkflowClient struct {
+	client client.Client
+}
+
+// NewGreeterTemporalClient initializes a new Greeter client
+func NewGreeterTemporalClient(c client.Client) GreeterTemporalClient {
+	return &greeterWorkflowClient{client: c}
+}
+
+// NewGreeterTemporalClientWithOptions initializes a new Greeter client with the given options
+func NewGreeterTemporalClientWithOptions(c client.Client, opts client.Options) (GreeterTemporalClient, error) {
+	var err error
+	c, err = client.NewClientFromExisting(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client with options: %w", err)
+	}
+	return &greeterWorkflowClient{client: c}, nil
+}
+
+// Greet greets someone.
+func (c *greeterWorkflowClient) Greet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	run, err := c.ExecuteGreet(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteGreet starts a Greet workflow
+func (c *greeterWorkflowClient) ExecuteGreet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (GreetRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(GreetIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "greeter"
+	}
+	run, err := c.client.ExecuteWorkflow(ctx, *opts, GreetWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, errors.New("execute workflow returned nil run")
+	}
+	return &greetRun{
+		client: c,
+		run:    run,
+	}, nil
+}
+
+// GetGreet fetches an existing Greet execution
+func (c *greeterWorkflowClient) GetGreet(ctx context.Context, workflowID string, runID string) (GreetRun, error) {
+	return &greetRun{
+		client: c,
+		run:    c.client.GetWorkflow(ctx, workflowID, runID),
+	}, nil
+}
+
+// GreetRun describes a Greet workflow run
+type GreetRun interface {
+	// ID returns the workflow ID
+	ID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*multiple.GreetResponse, error)
+}
+
+// greetRun provides an internal implementation of a GreetRun
+type greetRun struct {
+	client *greeterWorkflowClient
+	run    client.WorkflowRun
+}
+
+// ID returns the workflow ID
+func (r *greetRun) ID() string {
+	return r.run.GetID()
+}
+
+// RunID returns the execution ID
+func (r *greetRun) RunID() string {
+	return r.run.GetRunID()
+}
+
+// Get blocks until the workflow is complete, returning the result if applicable
+func (r *greetRun) Get(ctx context.Context) (*multiple.GreetResponse, error) {
+	var resp multiple.GreetResponse
+	if err := r.run.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GreeterWorkflows provides methods for initializing new Greeter workflow values
+type GreeterWorkflows interface {
+	// Greet initializes a new GreetWorkflow value
+	Greet(ctx workflow.Context, input *GreetInput) (GreetWorkflow, error)
+}
+
+// RegisterGreeterWorkflows registers Greeter workflows with the given worker
+func RegisterGreeterWorkflows(r worker.Registry, workflows GreeterWorkflows) {
+	RegisterGreetWorkflow(r, workflows.Greet)
+}
+
+// NewGreeterReplayer initializes a new workflow replayer with all Greeter workflows registered
+func NewGreeterReplayer(workflows GreeterWorkflows) worker.WorkflowReplayer {
+	r := worker.NewWorkflowReplayer()
+	r.RegisterWorkflowWithOptions(buildGreet(workflows.Greet), workflow.RegisterOptions{Name: GreetWorkflowName})
+	return r
+}
+
+// ReplayGreeterHistories replays every JSON workflow history in the given directory against the
+// given Greeter workflows, returning an error if any history fails to replay
+func ReplayGreeterHistories(workflows GreeterWorkflows, dir string) error {
+	return replayutil.ReplayDir(NewGreeterReplayer(workflows), nil, dir)
+}
+
+// RegisterGreetWorkflow registers a Greet workflow with the given worker
+func RegisterGreetWorkflow(r worker.Registry, wf func(workflow.Context, *GreetInput) (GreetWorkflow, error)) {
+	r.RegisterWorkflowWithOptions(buildGreet(wf), workflow.RegisterOptions{Name: GreetWorkflowName})
+}
+
+// buildGreet converts a Greet workflow struct into a valid workflow function
+func buildGreet(wf func(workflow.Context, *GreetInput) (GreetWorkflow, error)) func(workflow.Context, *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	return (&greet{wf}).Greet
+}
+
+// greet provides an Greet method for calling the user's implementation
+type greet struct {
+	ctor func(workflow.Context, *GreetInput) (GreetWorkflow, error)
+}
+
+// Greet constructs a new Greet value and executes it
+func (w *greet) Greet(ctx workflow.Context, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	input := &GreetInput{
+		Req: req,
+	}
+	wf, err := w.ctor(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return wf.Execute(ctx)
+}
+
+// GreetInput describes the input to a Greet workflow constructor
+type GreetInput struct {
+	Req *multiple.GreetRequest
+}
+
+// Greet greets someone.
+type GreetWorkflow interface {
+	// Execute a Greet workflow
+	Execute(ctx workflow.Context) (*multiple.GreetResponse, error)
+}
+
+// GreetChild executes a child Greet workflow
+func GreetChild(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *multiple.GreetRequest) *GreetChildRun {
+	if opts == nil {
+		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "greeter"
+		opts = &childOpts
+	}
+	if opts.WorkflowID == "" {
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(GreetIDExpression, req.ProtoReflect())
+			if err != nil {
+				return &GreetChildRun{Future: newGreeterFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(GreetIDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &GreetChildRun{Future: newGreeterFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &GreetChildRun{Future: newGreeterFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
+		}
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "greeter"
+	}
+	ctx = workflow.WithChildOptions(ctx, *opts)
+	return &GreetChildRun{Future: workflow.ExecuteChildWorkflow(ctx, GreetWorkflowName, req)}
+}
+
+// GreetChildRun describes a child Greet workflow run
+type GreetChildRun struct {
+	Future workflow.ChildWorkflowFuture
+}
+
+// Get blocks until the workflow is completed, returning the response value
+func (r *GreetChildRun) Get(ctx workflow.Context) (*multiple.GreetResponse, error) {
+	var resp multiple.GreetResponse
+	if err := r.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds this completion to the selector. Callback can be nil.
+func (r *GreetChildRun) Select(sel workflow.Selector, fn func(GreetChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// SelectStart adds waiting for start to the selector. Callback can be nil.
+func (r *GreetChildRun) SelectStart(sel workflow.Selector, fn func(GreetChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future.GetChildWorkflowExecution(), func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// WaitStart waits for the child workflow to start
+func (r *GreetChildRun) WaitStart(ctx workflow.Context) (*workflow.Execution, error) {
+	var exec workflow.Execution
+	if err := r.Future.GetChildWorkflowExecution().Get(ctx, &exec); err != nil {
+		return nil, err
+	}
+	return &exec, nil
+}
+
+// GreetContinueAsNew returns an error that instructs the current workflow to continue as a new Greet workflow,
+// applying the default task queue and timeouts
+func GreetContinueAsNew(ctx workflow.Context, req *multiple.GreetRequest) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "greeter")
+	return workflow.NewContinueAsNewError(ctx, GreetWorkflowName, req)
+}
+
+// greeterFailedChildFuture describes a child workflow future that failed before the child workflow was started
+type greeterFailedChildFuture struct {
+	workflow.Future
+}
+
+// newGreeterFailedChildFuture returns a child workflow future that has already failed with the given error
+func newGreeterFailedChildFuture(ctx workflow.Context, err error) *greeterFailedChildFuture {
+	future, settable := workflow.NewFuture(ctx)
+	settable.SetError(err)
+	return &greeterFailedChildFuture{Future: future}
+}
+
+// GetChildWorkflowExecution returns the failed future
+func (f *greeterFailedChildFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+// SignalChildWorkflow returns the failed future
+func (f *greeterFailedChildFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
+// GreeterActivities describes available worker activites
+type GreeterActivities interface {
+	// FormatGreeting formats a greeting.
+	FormatGreeting(ctx context.Context, req *multiple.GreetRequest) (*multiple.GreetResponse, error)
+}
+
+// RegisterGreeterActivities registers activities with a worker
+func RegisterGreeterActivities(r worker.Registry, activities GreeterActivities) {
+	RegisterFormatGreetingActivity(r, activities.FormatGreeting)
+}
+
+// RegisterFormatGreetingActivity registers a FormatGreeting activity
+func RegisterFormatGreetingActivity(r worker.Registry, fn func(context.Context, *multiple.GreetRequest) (*multiple.GreetResponse, error)) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: FormatGreetingActivityName,
+	})
+}
+
+// FormatGreetingFuture describes a FormatGreeting activity execution
+type FormatGreetingFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on a FormatGreeting execution, returning the response
+func (f *FormatGreetingFuture) Get(ctx workflow.Context) (*multiple.GreetResponse, error) {
+	var resp multiple.GreetResponse
+	if err := f.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds the FormatGreeting completion to the selector, callback can be nil
+func (f *FormatGreetingFuture) Select(sel workflow.Selector, fn func(*FormatGreetingFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// FormatGreeting formats a greeting.
+func FormatGreeting(ctx workflow.Context, opts *workflow.ActivityOptions, req *multiple.GreetRequest) *FormatGreetingFuture {
+	if opts == nil {
+		activityOpts := workflow.GetActivityOptions(ctx)
+		opts = &activityOpts
+	}
+	if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10s
+	}
+	ctx = workflow.WithActivityOptions(ctx, *opts)
+	return &FormatGreetingFuture{Future: workflow.ExecuteActivity(ctx, FormatGreetingActivityName, req)}
+}
+
+// FormatGreeting formats a greeting.
+func FormatGreetingLocal(ctx workflow.Context, opts *workflow.LocalActivityOptions, fn func(context.Context, *multiple.GreetRequest) (*multiple.GreetResponse, error), req *multiple.GreetRequest) *FormatGreetingFuture {
+	if opts == nil {
+		activityOpts := workflow.GetLocalActivityOptions(ctx)
+		opts = &activityOpts
+	}
+	if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10s
+	}
+	ctx = workflow.WithLocalActivityOptions(ctx, *opts)
+	var activity any
+	if fn == nil {
+		activity = FormatGreetingActivityName
+	} else {
+		activity = fn
+	}
+	return &FormatGreetingFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+}
+
+// Compile-time check that greeterWorkflowServer satisfies GreeterServer
+var _ multiple.GreeterServer = &greeterWorkflowServer{}
+
+// greeterWorkflowServer implements a GreeterServer that forwards requests to a Greeter client
+type greeterWorkflowServer struct {
+	multiple.UnimplementedGreeterServer
+	client GreeterTemporalClient
+}
+
+// NewGreeterServer initializes a new GreeterServer that forwards requests to the given Greeter client. Target
+// workflow and run ids are read from incoming gRPC metadata, falling back to the default id
+// expression when starting workflows
+func NewGreeterServer(c GreeterTemporalClient) multiple.GreeterServer {
+	return &greeterWorkflowServer{client: c}
+}
+
+// Greet greets someone.
+func (s *greeterWorkflowServer) Greet(ctx context.Context, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	resp, err := s.client.Greet(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// Compile-time check that greeterServerActivities satisfies GreeterActivities
+var _ GreeterActivities = &greeterServerActivities{}
+
+// greeterServerActivities implements GreeterActivities by calling a GreeterServer
+type greeterServerActivities struct {
+	server multiple.GreeterServer
+}
+
+// NewGreeterActivitiesFromServer initializes a new GreeterActivities value that calls the given GreeterServer,
+// converting gRPC status errors into temporal application errors
+func NewGreeterActivitiesFromServer(srv multiple.GreeterServer) GreeterActivities {
+	return &greeterServerActivities{server: srv}
+}
+
+// FormatGreeting calls the GreeterServer's FormatGreeting method
+func (a *greeterServerActivities) FormatGreeting(ctx context.Context, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	resp, err := a.server.FormatGreeting(ctx, req)
+	if err != nil {
+		return nil, grpcutil.ToApplicationError(err)
+	}
+	return resp, nil
+}
+
+// CounterTaskQueue is the default task-queue for a Counter worker
+const CounterTaskQueue = "counter"
+
+// Counter workflow names
+const (
+	CountWorkflowName = "mycompany.multiple.Count"
+)
+
+// Counter id expressions
+var (
+	CountIDExpression = expression.MustParseExpression("count/${!value}")
+)
+
+// Counter activity names
+const (
+	CountCharactersActivityName = "mycompany.multiple.Counter.CountCharactersActivity"
+)
+
+// CounterTemporalClient describes a client for a Counter worker
+type CounterTemporalClient interface {
+	// Count counts characters.
+	Count(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (*multiple.CountResponse, error)
+	// ExecuteCount executes a Count workflow
+	ExecuteCount(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (CountRun, error)
+	// GetCount retrieves a Count workflow execution
+	GetCount(ctx context.Context, workflowID string, runID string) (CountRun, error)
+}
+
+// Compile-time check that counterWorkflowClient satisfies CounterTemporalClient
+var _ CounterTemporalClient = &counterWorkflowClient{}
+
+// counterWorkflowClient implements a temporal client for a Counter service
+type counterWorkflowClient struct {
+	client client.Client
+}
+
+// NewCounterTemporalClient initializes a new Counter client
+func NewCounterTemporalClient(c client.Client) CounterTemporalClient {
+	return &counterWorkflowClient{client: c}
+}
+
+// NewCounterTemporalClientWithOptions initializes a new Counter client with the given options
+func NewCounterTemporalClientWithOptions(c client.Client, opts client.Options) (CounterTemporalClient, error) {
+	var err error
+	c, err = client.NewClientFromExisting(c, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing client with options: %w", err)
+	}
+	return &counterWorkflowClient{client: c}, nil
+}
+
+// Count counts characters.
+func (c *counterWorkflowClient) Count(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	run, err := c.ExecuteCount(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteCount starts a Count workflow
+func (c *counterWorkflowClient) ExecuteCount(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (CountRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(CountIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "counter"
+	}
+	run, err := c.client.ExecuteWorkflow(ctx, *opts, CountWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	if run == nil {
+		return nil, errors.New("execute workflow returned nil run")
+	}
+	return &countRun{
+		client: c,
+		run:    run,
+	}, nil
+}
+
+// GetCount fetches an existing Count execution
+func (c *counterWorkflowClient) GetCount(ctx context.Context, workflowID string, runID string) (CountRun, error) {
+	return &countRun{
+		client: c,
+		run:    c.client.GetWorkflow(ctx, workflowID, runID),
+	}, nil
+}
+
+// CountRun describes a Count workflow run
+type CountRun interface {
+	// ID returns the workflow ID
+	ID() string
+	// RunID returns the workflow instance ID
+	RunID() string
+	// Get blocks until the workflow is complete and returns the result
+	Get(ctx context.Context) (*multiple.CountResponse, error)
+}
+
+// countRun provides an internal implementation of a CountRun
+type countRun struct {
+	client *counterWorkflowClient
+	run    client.WorkflowRun
+}
+
+// ID returns the workflow ID
+func (r *countRun) ID() string {
+	return r.run.GetID()
+}
+
+// RunID returns the execution ID
+func (r *countRun) RunID() string {
+	return r.run.GetRunID()
+}
+
+// Get blocks until the workflow is complete, returning the result if applicable
+func (r *countRun) Get(ctx context.Context) (*multiple.CountResponse, error) {
+	var resp multiple.CountResponse
+	if err := r.run.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CounterWorkflows provides methods for initializing new Counter workflow values
+type CounterWorkflows interface {
+	// Count initializes a new CountWorkflow value
+	Count(ctx workflow.Context, input *CountInput) (CountWorkflow, error)
+}
+
+// RegisterCounterWorkflows registers Counter workflows with the given worker
+func RegisterCounterWorkflows(r worker.Registry, workflows CounterWorkflows) {
+	RegisterCountWorkflow(r, workflows.Count)
+}
+
+// NewCounterReplayer initializes a new workflow replayer with all Counter workflows registered
+func NewCounterReplayer(workflows CounterWorkflows) worker.WorkflowReplayer {
+	r := worker.NewWorkflowReplayer()
+	r.RegisterWorkflowWithOptions(buildCount(workflows.Count), workflow.RegisterOptions{Name: CountWorkflowName})
+	return r
+}
+
+// ReplayCounterHistories replays every JSON workflow history in the given directory against the
+// given Counter workflows, returning an error if any history fails to replay
+func ReplayCounterHistories(workflows CounterWorkflows, dir string) error {
+	return replayutil.ReplayDir(NewCounterReplayer(workflows), nil, dir)
+}
+
+// RegisterCountWorkflow registers a Count workflow with the given worker
+func RegisterCountWorkflow(r worker.Registry, wf func(workflow.Context, *CountInput) (CountWorkflow, error)) {
+	r.RegisterWorkflowWithOptions(buildCount(wf), workflow.RegisterOptions{Name: CountWorkflowName})
+}
+
+// buildCount converts a Count workflow struct into a valid workflow function
+func buildCount(wf func(workflow.Context, *CountInput) (CountWorkflow, error)) func(workflow.Context, *multiple.CountRequest) (*multiple.CountResponse, error) {
+	return (&count{wf}).Count
+}
+
+// count provides an Count method for calling the user's implementation
+type count struct {
+	ctor func(workflow.Context, *CountInput) (CountWorkflow, error)
+}
+
+// Count constructs a new Count value and executes it
+func (w *count) Count(ctx workflow.Context, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	input := &CountInput{
+		Req: req,
+	}
+	wf, err := w.ctor(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return wf.Execute(ctx)
+}
+
+// CountInput describes the input to a Count workflow constructor
+type CountInput struct {
+	Req *multiple.CountRequest
+}
+
+// Count counts characters.
+type CountWorkflow interface {
+	// Execute a Count workflow
+	Execute(ctx workflow.Context) (*multiple.CountResponse, error)
+}
+
+// CountChild executes a child Count workflow
+func CountChild(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *multiple.CountRequest) *CountChildRun {
+	if opts == nil {
+		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "counter"
+		opts = &childOpts
+	}
+	if opts.WorkflowID == "" {
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(CountIDExpression, req.ProtoReflect())
+			if err != nil {
+				return &CountChildRun{Future: newCounterFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(CountIDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &CountChildRun{Future: newCounterFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &CountChildRun{Future: newCounterFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
+		}
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "counter"
+	}
+	ctx = workflow.WithChildOptions(ctx, *opts)
+	return &CountChildRun{Future: workflow.ExecuteChildWorkflow(ctx, CountWorkflowName, req)}
+}
+
+// CountChildRun describes a child Count workflow run
+type CountChildRun struct {
+	Future workflow.ChildWorkflowFuture
+}
+
+// Get blocks until the workflow is completed, returning the response value
+func (r *CountChildRun) Get(ctx workflow.Context) (*multiple.CountResponse, error) {
+	var resp multiple.CountResponse
+	if err := r.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds this completion to the selector. Callback can be nil.
+func (r *CountChildRun) Select(sel workflow.Selector, fn func(CountChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// SelectStart adds waiting for start to the selector. Callback can be nil.
+func (r *CountChildRun) SelectStart(sel workflow.Selector, fn func(CountChildRun)) workflow.Selector {
+	return sel.AddFuture(r.Future.GetChildWorkflowExecution(), func(workflow.Future) {
+		if fn != nil {
+			fn(*r)
+		}
+	})
+}
+
+// WaitStart waits for the child workflow to start
+func (r *CountChildRun) WaitStart(ctx workflow.Context) (*workflow.Execution, error) {
+	var exec workflow.Execution
+	if err := r.Future.GetChildWorkflowExecution().Get(ctx, &exec); err != nil {
+		return nil, err
+	}
+	return &exec, nil
+}
+
+// CountContinueAsNew returns an error that instructs the current workflow to continue as a new Count workflow,
+// applying the default task queue and timeouts
+func CountContinueAsNew(ctx workflow.Context, req *multiple.CountRequest) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "counter")
+	return workflow.NewContinueAsNewError(ctx, CountWorkflowName, req)
+}
+
+// counterFailedChildFuture describes a child workflow future that failed before the child workflow was started
+type counterFailedChildFuture struct {
+	workflow.Future
+}
+
+// newCounterFailedChildFuture returns a child workflow future that has already failed with the given error
+func newCounterFailedChildFuture(ctx workflow.Context, err error) *counterFailedChildFuture {
+	future, settable := workflow.NewFuture(ctx)
+	settable.SetError(err)
+	return &counterFailedChildFuture{Future: future}
+}
+
+// GetChildWorkflowExecution returns the failed future
+func (f *counterFailedChildFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+// SignalChildWorkflow returns the failed future
+func (f *counterFailedChildFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
+// CounterActivities describes available worker activites
+type CounterActivities interface {
+	// CountCharacters counts the characters in a value.
+	CountCharacters(ctx context.Context, req *multiple.CountRequest) (*multiple.CountResponse, error)
+}
+
+// RegisterCounterActivities registers activities with a worker
+func RegisterCounterActivities(r worker.Registry, activities CounterActivities) {
+	RegisterCountCharactersActivity(r, activities.CountCharacters)
+}
+
+// RegisterCountCharactersActivity registers a CountCharacters activity
+func RegisterCountCharactersActivity(r worker.Registry, fn func(context.Context, *multiple.CountRequest) (*multiple.CountResponse, error)) {
+	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
+		Name: CountCharactersActivityName,
+	})
+}
+
+// CountCharactersFuture describes a CountCharacters activity execution
+type CountCharactersFuture struct {
+	Future workflow.Future
+}
+
+// Get blocks on a CountCharacters execution, returning the response
+func (f *CountCharactersFuture) Get(ctx workflow.Context) (*multiple.CountResponse, error) {
+	var resp multiple.CountResponse
+	if err := f.Future.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Select adds the CountCharacters completion to the selector, callback can be nil
+func (f *CountCharactersFuture) Select(sel workflow.Selector, fn func(*CountCharactersFuture)) workflow.Selector {
+	return sel.AddFuture(f.Future, func(workflow.Future) {
+		if fn != nil {
+			fn(f)
+		}
+	})
+}
+
+// CountCharacters counts the characters in a value.
+func CountCharacters(ctx workflow.Context, opts *workflow.ActivityOptions, req *multiple.CountRequest) *CountCharactersFuture {
+	if opts == nil {
+		activityOpts := workflow.GetActivityOptions(ctx)
+		opts = &activityOpts
+	}
+	if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10s
+	}
+	ctx = workflow.WithActivityOptions(ctx, *opts)
+	return &CountCharactersFuture{Future: workflow.ExecuteActivity(ctx, CountCharactersActivityName, req)}
+}
+
+// CountCharacters counts the characters in a value.
+func CountCharactersLocal(ctx workflow.Context, opts *workflow.LocalActivityOptions, fn func(context.Context, *multiple.CountRequest) (*multiple.CountResponse, error), req *multiple.CountRequest) *CountCharactersFuture {
+	if opts == nil {
+		activityOpts := workflow.GetLocalActivityOptions(ctx)
+		opts = &activityOpts
+	}
+	if opts.StartToCloseTimeout == 0 {
+		opts.StartToCloseTimeout = 10000000000 // 10s
+	}
+	ctx = workflow.WithLocalActivityOptions(ctx, *opts)
+	var activity any
+	if fn == nil {
+		activity = CountCharactersActivityName
+	} else {
+		activity = fn
+	}
+	return &CountCharactersFuture{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
+}
+
+// Compile-time check that counterWorkflowServer satisfies CounterServer
+var _ multiple.CounterServer = &counterWorkflowServer{}
+
+// counterWorkflowServer implements a CounterServer that forwards requests to a Counter client
+type counterWorkflowServer struct {
+	multiple.UnimplementedCounterServer
+	client CounterTemporalClient
+}
+
+// NewCounterServer initializes a new CounterServer that forwards requests to the given Counter client. Target
+// workflow and run ids are read from incoming gRPC metadata, falling back to the default id
+// expression when starting workflows
+func NewCounterServer(c CounterTemporalClient) multiple.CounterServer {
+	return &counterWorkflowServer{client: c}
+}
+
+// Count counts characters.
+func (s *counterWorkflowServer) Count(ctx context.Context, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	resp, err := s.client.Count(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// Compile-time check that counterServerActivities satisfies CounterActivities
+var _ CounterActivities = &counterServerActivities{}
+
+// counterServerActivities implements CounterActivities by calling a CounterServer
+type counterServerActivities struct {
+	server multiple.CounterServer
+}
+
+// NewCounterActivitiesFromServer initializes a new CounterActivities value that calls the given CounterServer,
+// converting gRPC status errors into temporal application errors
+func NewCounterActivitiesFromServer(srv multiple.CounterServer) CounterActivities {
+	return &counterServerActivities{server: srv}
+}
+
+// CountCharacters calls the CounterServer's CountCharacters method
+func (a *counterServerActivities) CountCharacters(ctx context.Context, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	resp, err := a.server.CountCharacters(ctx, req)
+	if err != nil {
+		return nil, grpcutil.ToApplicationError(err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,412 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: multiple/multiple.proto
+package multipletemporal
+
+import (
+	"context"
+	"github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	mock "github.com/stretchr/testify/mock"
+	client "go.temporal.io/sdk/client"
+)
+
+// Compile-time check that MockGreeterTemporalClient satisfies GreeterTemporalClient
+var _ GreeterTemporalClient = &MockGreeterTemporalClient{}
+
+// MockGreeterTemporalClient is a testify mock implementation of GreeterTemporalClient
+type MockGreeterTemporalClient struct {
+	mock.Mock
+}
+
+// NewMockGreeterTemporalClient initializes a new MockGreeterTemporalClient that asserts its expectations when the test completes
+func NewMockGreeterTemporalClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGreeterTemporalClient {
+	m := &MockGreeterTemporalClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// Greet implements GreeterTemporalClient.Greet
+func (m *MockGreeterTemporalClient) Greet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 *multiple.GreetResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*multiple.GreetResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGreet registers an expectation for a Greet call
+func (m *MockGreeterTemporalClient) OnGreet(ctx any, opts any, req any) *MockGreeterTemporalClientGreetCall {
+	return &MockGreeterTemporalClientGreetCall{Call: m.On("Greet", ctx, opts, req)}
+}
+
+// MockGreeterTemporalClientGreetCall wraps a Greet expectation with typed return values
+type MockGreeterTemporalClientGreetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Greet call
+func (c *MockGreeterTemporalClientGreetCall) Return(r0 *multiple.GreetResponse, err error) *MockGreeterTemporalClientGreetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// ExecuteGreet implements GreeterTemporalClient.ExecuteGreet
+func (m *MockGreeterTemporalClient) ExecuteGreet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (GreetRun, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 GreetRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(GreetRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteGreet registers an expectation for a ExecuteGreet call
+func (m *MockGreeterTemporalClient) OnExecuteGreet(ctx any, opts any, req any) *MockGreeterTemporalClientExecuteGreetCall {
+	return &MockGreeterTemporalClientExecuteGreetCall{Call: m.On("ExecuteGreet", ctx, opts, req)}
+}
+
+// MockGreeterTemporalClientExecuteGreetCall wraps a ExecuteGreet expectation with typed return values
+type MockGreeterTemporalClientExecuteGreetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteGreet call
+func (c *MockGreeterTemporalClientExecuteGreetCall) Return(r0 GreetRun, err error) *MockGreeterTemporalClientExecuteGreetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetGreet implements GreeterTemporalClient.GetGreet
+func (m *MockGreeterTemporalClient) GetGreet(ctx context.Context, workflowID string, runID string) (GreetRun, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 GreetRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(GreetRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetGreet registers an expectation for a GetGreet call
+func (m *MockGreeterTemporalClient) OnGetGreet(ctx any, workflowID any, runID any) *MockGreeterTemporalClientGetGreetCall {
+	return &MockGreeterTemporalClientGetGreetCall{Call: m.On("GetGreet", ctx, workflowID, runID)}
+}
+
+// MockGreeterTemporalClientGetGreetCall wraps a GetGreet expectation with typed return values
+type MockGreeterTemporalClientGetGreetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetGreet call
+func (c *MockGreeterTemporalClientGetGreetCall) Return(r0 GreetRun, err error) *MockGreeterTemporalClientGetGreetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// Compile-time check that MockGreetRun satisfies GreetRun
+var _ GreetRun = &MockGreetRun{}
+
+// MockGreetRun is a testify mock implementation of GreetRun
+type MockGreetRun struct {
+	mock.Mock
+}
+
+// NewMockGreetRun initializes a new MockGreetRun that asserts its expectations when the test completes
+func NewMockGreetRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGreetRun {
+	m := &MockGreetRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements GreetRun.ID
+func (m *MockGreetRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockGreetRun) OnID() *MockGreetRunIDCall {
+	return &MockGreetRunIDCall{Call: m.On("ID")}
+}
+
+// MockGreetRunIDCall wraps a ID expectation with typed return values
+type MockGreetRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockGreetRunIDCall) Return(r0 string) *MockGreetRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements GreetRun.RunID
+func (m *MockGreetRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockGreetRun) OnRunID() *MockGreetRunRunIDCall {
+	return &MockGreetRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockGreetRunRunIDCall wraps a RunID expectation with typed return values
+type MockGreetRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockGreetRunRunIDCall) Return(r0 string) *MockGreetRunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements GreetRun.Get
+func (m *MockGreetRun) Get(ctx context.Context) (*multiple.GreetResponse, error) {
+	args := m.Called(ctx)
+	var r0 *multiple.GreetResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*multiple.GreetResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockGreetRun) OnGet(ctx any) *MockGreetRunGetCall {
+	return &MockGreetRunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockGreetRunGetCall wraps a Get expectation with typed return values
+type MockGreetRunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockGreetRunGetCall) Return(r0 *multiple.GreetResponse, err error) *MockGreetRunGetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// Compile-time check that MockCounterTemporalClient satisfies CounterTemporalClient
+var _ CounterTemporalClient = &MockCounterTemporalClient{}
+
+// MockCounterTemporalClient is a testify mock implementation of CounterTemporalClient
+type MockCounterTemporalClient struct {
+	mock.Mock
+}
+
+// NewMockCounterTemporalClient initializes a new MockCounterTemporalClient that asserts its expectations when the test completes
+func NewMockCounterTemporalClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCounterTemporalClient {
+	m := &MockCounterTemporalClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// Count implements CounterTemporalClient.Count
+func (m *MockCounterTemporalClient) Count(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 *multiple.CountResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*multiple.CountResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnCount registers an expectation for a Count call
+func (m *MockCounterTemporalClient) OnCount(ctx any, opts any, req any) *MockCounterTemporalClientCountCall {
+	return &MockCounterTemporalClientCountCall{Call: m.On("Count", ctx, opts, req)}
+}
+
+// MockCounterTemporalClientCountCall wraps a Count expectation with typed return values
+type MockCounterTemporalClientCountCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Count call
+func (c *MockCounterTemporalClientCountCall) Return(r0 *multiple.CountResponse, err error) *MockCounterTemporalClientCountCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// ExecuteCount implements CounterTemporalClient.ExecuteCount
+func (m *MockCounterTemporalClient) ExecuteCount(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (CountRun, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 CountRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(CountRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteCount registers an expectation for a ExecuteCount call
+func (m *MockCounterTemporalClient) OnExecuteCount(ctx any, opts any, req any) *MockCounterTemporalClientExecuteCountCall {
+	return &MockCounterTemporalClientExecuteCountCall{Call: m.On("ExecuteCount", ctx, opts, req)}
+}
+
+// MockCounterTemporalClientExecuteCountCall wraps a ExecuteCount expectation with typed return values
+type MockCounterTemporalClientExecuteCountCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteCount call
+func (c *MockCounterTemporalClientExecuteCountCall) Return(r0 CountRun, err error) *MockCounterTemporalClientExecuteCountCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetCount implements CounterTemporalClient.GetCount
+func (m *MockCounterTemporalClient) GetCount(ctx context.Context, workflowID string, runID string) (CountRun, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 CountRun
+	if v := args.Get(0); v != nil {
+		r0 = v.(CountRun)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetCount registers an expectation for a GetCount call
+func (m *MockCounterTemporalClient) OnGetCount(ctx any, workflowID any, runID any) *MockCounterTemporalClientGetCountCall {
+	return &MockCounterTemporalClientGetCountCall{Call: m.On("GetCount", ctx, workflowID, runID)}
+}
+
+// MockCounterTemporalClientGetCountCall wraps a GetCount expectation with typed return values
+type MockCounterTemporalClientGetCountCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetCount call
+func (c *MockCounterTemporalClientGetCountCall) Return(r0 CountRun, err error) *MockCounterTemporalClientGetCountCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// Compile-time check that MockCountRun satisfies CountRun
+var _ CountRun = &MockCountRun{}
+
+// MockCountRun is a testify mock implementation of CountRun
+type MockCountRun struct {
+	mock.Mock
+}
+
+// NewMockCountRun initializes a new MockCountRun that asserts its expectations when the test completes
+func NewMockCountRun(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCountRun {
+	m := &MockCountRun{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements CountRun.ID
+func (m *MockCountRun) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockCountRun) OnID() *MockCountRunIDCall {
+	return &MockCountRunIDCall{Call: m.On("ID")}
+}
+
+// MockCountRunIDCall wraps a ID expectation with typed return values
+type MockCountRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockCountRunIDCall) Return(r0 string) *MockCountRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements CountRun.RunID
+func (m *MockCountRun) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockCountRun) OnRunID() *MockCountRunRunIDCall {
+	return &MockCountRunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockCountRunRunIDCall wraps a RunID expectation with typed return values
+type MockCountRunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockCountRunRunIDCall) Return(r0 string) *MockCountRunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements CountRun.Get
+func (m *MockCountRun) Get(ctx context.Context) (*multiple.CountResponse, error) {
+	args := m.Called(ctx)
+	var r0 *multiple.CountResponse
+	if v := args.Get(0); v != nil {
+		r0 = v.(*multiple.CountResponse)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockCountRun) OnGet(ctx any) *MockCountRunGetCall {
+	return &MockCountRunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockCountRunGetCall wraps a Get expectation with typed return values
+type MockCountRunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockCountRunGetCall) Return(r0 *multiple.CountResponse, err error) *MockCountRunGetCall {
+	c.Call.Return(r0, err)
+	return c
+}
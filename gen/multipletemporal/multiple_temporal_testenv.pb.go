@@ -0,0 +1,438 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: multiple/multiple.proto
+package multipletemporal
+
+import (
+	"context"
+	"fmt"
+	"github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	serviceerror "go.temporal.io/api/serviceerror"
+	client "go.temporal.io/sdk/client"
+	testsuite "go.temporal.io/sdk/testsuite"
+	"sync"
+)
+
+// GreeterTestEnv wraps a testsuite.TestWorkflowEnvironment with typed helpers for Greeter workflows
+type GreeterTestEnv struct {
+	*testsuite.TestWorkflowEnvironment
+}
+
+// NewGreeterTestEnv initializes a new GreeterTestEnv that registers the given workflows with the test environment.
+// When activities is nil, placeholder activities are registered that must be mocked using the
+// On<Activity> helpers
+func NewGreeterTestEnv(env *testsuite.TestWorkflowEnvironment, workflows GreeterWorkflows, activities GreeterActivities) *GreeterTestEnv {
+	RegisterGreeterWorkflows(env, workflows)
+	if activities == nil {
+		activities = &greeterTestEnvActivities{}
+	}
+	RegisterGreeterActivities(env, activities)
+	return &GreeterTestEnv{env}
+}
+
+// ExecuteGreet executes a Greet workflow in the test environment and blocks until it completes
+func (e *GreeterTestEnv) ExecuteGreet(req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	e.ExecuteWorkflow(GreetWorkflowName, req)
+	if err := e.GetWorkflowError(); err != nil {
+		return nil, err
+	}
+	var resp multiple.GreetResponse
+	if err := e.GetWorkflowResult(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// OnFormatGreeting mocks a FormatGreeting activity execution in the test environment
+func (e *GreeterTestEnv) OnFormatGreeting(req any) *GreeterTestEnvFormatGreetingCall {
+	return &GreeterTestEnvFormatGreetingCall{e.OnActivity(FormatGreetingActivityName, mock.Anything, req)}
+}
+
+// GreeterTestEnvFormatGreetingCall wraps a FormatGreeting activity mock with typed return values
+type GreeterTestEnvFormatGreetingCall struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked FormatGreeting activity
+func (c *GreeterTestEnvFormatGreetingCall) Return(resp *multiple.GreetResponse, err error) *GreeterTestEnvFormatGreetingCall {
+	c.MockCallWrapper.Return(resp, err)
+	return c
+}
+
+// greeterTestEnvActivities provides placeholder activities that must be mocked
+type greeterTestEnvActivities struct{}
+
+// FormatGreeting returns an error indicating that the activity was not mocked
+func (a *greeterTestEnvActivities) FormatGreeting(ctx context.Context, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	return nil, fmt.Errorf("%s activity is not mocked", FormatGreetingActivityName)
+}
+
+// Compile-time check that GreeterTestClient satisfies GreeterTemporalClient
+var _ GreeterTemporalClient = &GreeterTestClient{}
+
+// GreeterTestClient is an in-memory GreeterTemporalClient implementation that executes workflows using a GreeterTestEnv.
+// Signals sent before a workflow is executed are delivered when it starts, while Get, queries,
+// and updates execute the workflow to completion on first use
+type GreeterTestClient struct {
+	newEnv func() *GreeterTestEnv
+	mu     sync.Mutex
+	runs   map[string]*greeterTestClientRun
+}
+
+// NewGreeterTestClient initializes a new GreeterTestClient that calls newEnv to create the GreeterTestEnv used by each
+// workflow execution
+func NewGreeterTestClient(newEnv func() *GreeterTestEnv) *GreeterTestClient {
+	return &GreeterTestClient{
+		newEnv: newEnv,
+		runs:   map[string]*greeterTestClientRun{},
+	}
+}
+
+// start initializes a new workflow execution using a new test environment
+func (c *GreeterTestClient) start(opts *client.StartWorkflowOptions, workflow string, args ...any) (*greeterTestClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if opts.ID == "" {
+		opts.ID = uuid.NewString()
+	}
+	if run, ok := c.runs[opts.ID]; ok {
+		run.mu.Lock()
+		executed := run.executed
+		run.mu.Unlock()
+		if !executed {
+			return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(fmt.Sprintf("workflow %s already started", opts.ID), "", run.runID)
+		}
+	}
+	env := c.newEnv()
+	env.SetStartWorkflowOptions(*opts)
+	run := &greeterTestClientRun{
+		args:     args,
+		env:      env,
+		id:       opts.ID,
+		runID:    uuid.NewString(),
+		workflow: workflow,
+	}
+	c.runs[opts.ID] = run
+	return run, nil
+}
+
+// run returns an existing workflow execution
+func (c *GreeterTestClient) run(workflowID string, runID string) (*greeterTestClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	run, ok := c.runs[workflowID]
+	if !ok || (runID != "" && runID != run.runID) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow %s not found", workflowID))
+	}
+	return run, nil
+}
+
+// Greet executes a Greet workflow in a new test environment and blocks until it completes
+func (c *GreeterTestClient) Greet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (*multiple.GreetResponse, error) {
+	run, err := c.ExecuteGreet(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteGreet starts a Greet workflow in a new test environment
+func (c *GreeterTestClient) ExecuteGreet(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.GreetRequest) (GreetRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(GreetIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "greeter"
+	}
+	run, err := c.start(opts, GreetWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testGreetRun{run}, nil
+}
+
+// GetGreet fetches an existing Greet execution started by the test client
+func (c *GreeterTestClient) GetGreet(ctx context.Context, workflowID string, runID string) (GreetRun, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testGreetRun{run}, nil
+}
+
+// greeterTestClientRun describes a workflow execution started by a GreeterTestClient
+type greeterTestClientRun struct {
+	mu       sync.Mutex
+	env      *GreeterTestEnv
+	id       string
+	runID    string
+	workflow string
+	args     []any
+	executed bool
+}
+
+// execute runs the workflow to completion if it has not already been executed
+func (r *greeterTestClientRun) execute() {
+	if !r.executed {
+		r.executed = true
+		r.env.ExecuteWorkflow(r.workflow, r.args...)
+	}
+}
+
+// get executes the workflow and decodes its result into resp, if not nil
+func (r *greeterTestClientRun) get(resp any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.execute()
+	if err := r.env.GetWorkflowError(); err != nil || resp == nil {
+		return err
+	}
+	return r.env.GetWorkflowResult(resp)
+}
+
+// testGreetRun provides a GreetRun implementation for executions started by a GreeterTestClient
+type testGreetRun struct {
+	run *greeterTestClientRun
+}
+
+// ID returns the workflow ID
+func (r *testGreetRun) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testGreetRun) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testGreetRun) Get(ctx context.Context) (*multiple.GreetResponse, error) {
+	var resp multiple.GreetResponse
+	if err := r.run.get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// CounterTestEnv wraps a testsuite.TestWorkflowEnvironment with typed helpers for Counter workflows
+type CounterTestEnv struct {
+	*testsuite.TestWorkflowEnvironment
+}
+
+// NewCounterTestEnv initializes a new CounterTestEnv that registers the given workflows with the test environment.
+// When activities is nil, placeholder activities are registered that must be mocked using the
+// On<Activity> helpers
+func NewCounterTestEnv(env *testsuite.TestWorkflowEnvironment, workflows CounterWorkflows, activities CounterActivities) *CounterTestEnv {
+	RegisterCounterWorkflows(env, workflows)
+	if activities == nil {
+		activities = &counterTestEnvActivities{}
+	}
+	RegisterCounterActivities(env, activities)
+	return &CounterTestEnv{env}
+}
+
+// ExecuteCount executes a Count workflow in the test environment and blocks until it completes
+func (e *CounterTestEnv) ExecuteCount(req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	e.ExecuteWorkflow(CountWorkflowName, req)
+	if err := e.GetWorkflowError(); err != nil {
+		return nil, err
+	}
+	var resp multiple.CountResponse
+	if err := e.GetWorkflowResult(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// OnCountCharacters mocks a CountCharacters activity execution in the test environment
+func (e *CounterTestEnv) OnCountCharacters(req any) *CounterTestEnvCountCharactersCall {
+	return &CounterTestEnvCountCharactersCall{e.OnActivity(CountCharactersActivityName, mock.Anything, req)}
+}
+
+// CounterTestEnvCountCharactersCall wraps a CountCharacters activity mock with typed return values
+type CounterTestEnvCountCharactersCall struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked CountCharacters activity
+func (c *CounterTestEnvCountCharactersCall) Return(resp *multiple.CountResponse, err error) *CounterTestEnvCountCharactersCall {
+	c.MockCallWrapper.Return(resp, err)
+	return c
+}
+
+// counterTestEnvActivities provides placeholder activities that must be mocked
+type counterTestEnvActivities struct{}
+
+// CountCharacters returns an error indicating that the activity was not mocked
+func (a *counterTestEnvActivities) CountCharacters(ctx context.Context, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	return nil, fmt.Errorf("%s activity is not mocked", CountCharactersActivityName)
+}
+
+// Compile-time check that CounterTestClient satisfies CounterTemporalClient
+var _ CounterTemporalClient = &CounterTestClient{}
+
+// CounterTestClient is an in-memory CounterTemporalClient implementation that executes workflows using a CounterTestEnv.
+// Signals sent before a workflow is executed are delivered when it starts, while Get, queries,
+// and updates execute the workflow to completion on first use
+type CounterTestClient struct {
+	newEnv func() *CounterTestEnv
+	mu     sync.Mutex
+	runs   map[string]*counterTestClientRun
+}
+
+// NewCounterTestClient initializes a new CounterTestClient that calls newEnv to create the CounterTestEnv used by each
+// workflow execution
+func NewCounterTestClient(newEnv func() *CounterTestEnv) *CounterTestClient {
+	return &CounterTestClient{
+		newEnv: newEnv,
+		runs:   map[string]*counterTestClientRun{},
+	}
+}
+
+// start initializes a new workflow execution using a new test environment
+func (c *CounterTestClient) start(opts *client.StartWorkflowOptions, workflow string, args ...any) (*counterTestClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if opts.ID == "" {
+		opts.ID = uuid.NewString()
+	}
+	if run, ok := c.runs[opts.ID]; ok {
+		run.mu.Lock()
+		executed := run.executed
+		run.mu.Unlock()
+		if !executed {
+			return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(fmt.Sprintf("workflow %s already started", opts.ID), "", run.runID)
+		}
+	}
+	env := c.newEnv()
+	env.SetStartWorkflowOptions(*opts)
+	run := &counterTestClientRun{
+		args:     args,
+		env:      env,
+		id:       opts.ID,
+		runID:    uuid.NewString(),
+		workflow: workflow,
+	}
+	c.runs[opts.ID] = run
+	return run, nil
+}
+
+// run returns an existing workflow execution
+func (c *CounterTestClient) run(workflowID string, runID string) (*counterTestClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	run, ok := c.runs[workflowID]
+	if !ok || (runID != "" && runID != run.runID) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow %s not found", workflowID))
+	}
+	return run, nil
+}
+
+// Count executes a Count workflow in a new test environment and blocks until it completes
+func (c *CounterTestClient) Count(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (*multiple.CountResponse, error) {
+	run, err := c.ExecuteCount(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteCount starts a Count workflow in a new test environment
+func (c *CounterTestClient) ExecuteCount(ctx context.Context, opts *client.StartWorkflowOptions, req *multiple.CountRequest) (CountRun, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(CountIDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "counter"
+	}
+	run, err := c.start(opts, CountWorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testCountRun{run}, nil
+}
+
+// GetCount fetches an existing Count execution started by the test client
+func (c *CounterTestClient) GetCount(ctx context.Context, workflowID string, runID string) (CountRun, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testCountRun{run}, nil
+}
+
+// counterTestClientRun describes a workflow execution started by a CounterTestClient
+type counterTestClientRun struct {
+	mu       sync.Mutex
+	env      *CounterTestEnv
+	id       string
+	runID    string
+	workflow string
+	args     []any
+	executed bool
+}
+
+// execute runs the workflow to completion if it has not already been executed
+func (r *counterTestClientRun) execute() {
+	if !r.executed {
+		r.executed = true
+		r.env.ExecuteWorkflow(r.workflow, r.args...)
+	}
+}
+
+// get executes the workflow and decodes its result into resp, if not nil
+func (r *counterTestClientRun) get(resp any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.execute()
+	if err := r.env.GetWorkflowError(); err != nil || resp == nil {
+		return err
+	}
+	return r.env.GetWorkflowResult(resp)
+}
+
+// testCountRun provides a CountRun implementation for executions started by a CounterTestClient
+type testCountRun struct {
+	run *counterTestClientRun
+}
+
+// ID returns the workflow ID
+func (r *testCountRun) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testCountRun) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testCountRun) Get(ctx context.Context) (*multiple.CountResponse, error) {
+	var resp multiple.CountResponse
+	if err := r.run.get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -558,6 +558,100 @@ func (x *SomeSignal2Request) GetRequestVal() string {
 	return ""
 }
 
+type SomeUpdate1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequestVal string `protobuf:"bytes,1,opt,name=request_val,json=requestVal,proto3" json:"request_val,omitempty"`
+}
+
+func (x *SomeUpdate1Request) Reset() {
+	*x = SomeUpdate1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simple_simple_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SomeUpdate1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SomeUpdate1Request) ProtoMessage() {}
+
+func (x *SomeUpdate1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_simple_simple_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SomeUpdate1Request.ProtoReflect.Descriptor instead.
+func (*SomeUpdate1Request) Descriptor() ([]byte, []int) {
+	return file_simple_simple_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SomeUpdate1Request) GetRequestVal() string {
+	if x != nil {
+		return x.RequestVal
+	}
+	return ""
+}
+
+type SomeUpdate1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResponseVal string `protobuf:"bytes,1,opt,name=response_val,json=responseVal,proto3" json:"response_val,omitempty"`
+}
+
+func (x *SomeUpdate1Response) Reset() {
+	*x = SomeUpdate1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_simple_simple_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SomeUpdate1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SomeUpdate1Response) ProtoMessage() {}
+
+func (x *SomeUpdate1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_simple_simple_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SomeUpdate1Response.ProtoReflect.Descriptor instead.
+func (*SomeUpdate1Response) Descriptor() ([]byte, []int) {
+	return file_simple_simple_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SomeUpdate1Response) GetResponseVal() string {
+	if x != nil {
+		return x.ResponseVal
+	}
+	return ""
+}
+
 type SomeWorkflow1Request_OuterNested struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -571,7 +665,7 @@ type SomeWorkflow1Request_OuterNested struct {
 func (x *SomeWorkflow1Request_OuterNested) Reset() {
 	*x = SomeWorkflow1Request_OuterNested{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[10]
+		mi := &file_simple_simple_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -584,7 +678,7 @@ func (x *SomeWorkflow1Request_OuterNested) String() string {
 func (*SomeWorkflow1Request_OuterNested) ProtoMessage() {}
 
 func (x *SomeWorkflow1Request_OuterNested) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[10]
+	mi := &file_simple_simple_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -632,7 +726,7 @@ type SomeWorkflow1Request_OuterNested_InnerNested struct {
 func (x *SomeWorkflow1Request_OuterNested_InnerNested) Reset() {
 	*x = SomeWorkflow1Request_OuterNested_InnerNested{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_simple_simple_proto_msgTypes[11]
+		mi := &file_simple_simple_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +739,7 @@ func (x *SomeWorkflow1Request_OuterNested_InnerNested) String() string {
 func (*SomeWorkflow1Request_OuterNested_InnerNested) ProtoMessage() {}
 
 func (x *SomeWorkflow1Request_OuterNested_InnerNested) ProtoReflect() protoreflect.Message {
-	mi := &file_simple_simple_proto_msgTypes[11]
+	mi := &file_simple_simple_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -749,95 +843,118 @@ var file_simple_simple_proto_rawDesc = []byte{
 	0x65, 0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
 	0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x32, 0xb6, 0x09, 0x0a, 0x06,
-	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0xea, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x8a, 0xc4, 0x03, 0x82,
-	0x01, 0x0a, 0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x0a,
-	0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12, 0x0d, 0x0a,
-	0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x0d, 0x0a, 0x0b,
-	0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x1a, 0x1e, 0x6d, 0x79, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
-	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x32, 0x26, 0x62, 0x24, 0x73,
-	0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x31, 0x2f, 0x24,
-	0x7b, 0x21, 0x69, 0x64, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34,
-	0x28, 0x29, 0x7d, 0x12, 0x76, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66,
-	0x6c, 0x6f, 0x77, 0x32, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x8a, 0xc4, 0x03, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x6f,
-	0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x10, 0x01, 0x1a, 0x1e, 0x6d, 0x79, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
-	0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x12, 0xac, 0x01, 0x0a, 0x0d,
-	0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x12, 0x26, 0x2e,
+	0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x22, 0x35, 0x0a, 0x12, 0x53,
+	0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56,
+	0x61, 0x6c, 0x22, 0x38, 0x0a, 0x13, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x61, 0x6c, 0x32, 0xb2, 0x0b, 0x0a,
+	0x06, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x8c, 0x02, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x01, 0x8a, 0xc4, 0x03,
+	0xa4, 0x01, 0x0a, 0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31,
+	0x0a, 0x0c, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12, 0x0d,
+	0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x0d, 0x0a,
+	0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x1a, 0x1e, 0x6d, 0x79,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
+	0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x31, 0x32, 0x26, 0x62, 0x24,
+	0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2d, 0x31, 0x2f,
+	0x24, 0x7b, 0x21, 0x69, 0x64, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76,
+	0x34, 0x28, 0x29, 0x7d, 0x3a, 0x0d, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x31, 0x4a, 0x05, 0x08, 0x90, 0x4e, 0x10, 0x01, 0x52, 0x0a, 0x76, 0x32, 0x2d, 0x62,
+	0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x76, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x35, 0x8a, 0xc4, 0x03, 0x31, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x10, 0x01, 0x1a, 0x1e,
 	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5b, 0x8a,
-	0xc4, 0x03, 0x57, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x32, 0x10, 0x01, 0x32, 0x44, 0x0a, 0x0f, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x2d, 0x32, 0x12, 0x02, 0x20, 0x02, 0x28, 0x01, 0x32, 0x03, 0x08,
-	0x90, 0x1c, 0x62, 0x26, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x32, 0x12, 0xde,
+	0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x33,
+	0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x8c, 0x01, 0x8a, 0xc4, 0x03, 0x87, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x10, 0x01, 0x32, 0x5e, 0x0a, 0x0f, 0x6d, 0x79, 0x2d,
+	0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x2d, 0x32, 0x12, 0x02, 0x20, 0x02,
+	0x28, 0x01, 0x32, 0x03, 0x08, 0x90, 0x1c, 0x3a, 0x03, 0x08, 0x88, 0x0e, 0x42, 0x02, 0x08, 0x1e,
+	0x4a, 0x0c, 0x6d, 0x79, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x50, 0x02,
+	0x58, 0x01, 0x62, 0x25, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
 	0x77, 0x2d, 0x33, 0x2f, 0x24, 0x7b, 0x21, 0x69, 0x64, 0x7d, 0x2f, 0x24, 0x7b, 0x21, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x7d, 0x12, 0x65, 0x0a, 0x0d, 0x53, 0x6f,
-	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x92, 0xc4, 0x03,
-	0x20, 0x12, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x31, 0x12, 0x61, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x32, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x10, 0x92, 0xc4, 0x03, 0x0c, 0x0a, 0x0a, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x04,
-	0x1a, 0x02, 0x08, 0x1e, 0x12, 0x70, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x69, 0x74, 0x79, 0x33, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x7d, 0x42, 0x14, 0x12, 0x05, 0x0a, 0x03, 0x08,
+	0x90, 0x1c, 0x1a, 0x02, 0x08, 0x3c, 0x20, 0x01, 0x2a, 0x03, 0x08, 0xd8, 0x04, 0x30, 0x01, 0x12,
+	0x67, 0x0a, 0x0d, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x31,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x22, 0x26, 0x92, 0xc4, 0x03, 0x22, 0x12, 0x1e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
 	0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
-	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0x92, 0xc4, 0x03, 0x0a, 0x0a, 0x08, 0x22, 0x02,
-	0x08, 0x0a, 0x32, 0x02, 0x20, 0x05, 0x12, 0x50, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x6d,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x31, 0x28, 0x01, 0x12, 0xa0, 0x01, 0x0a, 0x0d, 0x53, 0x6f, 0x6d,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x12, 0x26, 0x2e, 0x6d, 0x79, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f,
+	0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x4f, 0x92, 0xc4, 0x03, 0x4b,
+	0x0a, 0x3f, 0x0a, 0x0f, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x2d, 0x32, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x04, 0x1a, 0x02, 0x08, 0x1e, 0x38, 0x01, 0x40,
+	0x01, 0x4a, 0x1e, 0x73, 0x6f, 0x6d, 0x65, 0x2d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x2d, 0x32, 0x2f, 0x24, 0x7b, 0x21, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x56, 0x61, 0x6c,
+	0x7d, 0x22, 0x08, 0x0a, 0x02, 0x08, 0x05, 0x1a, 0x02, 0x20, 0x03, 0x12, 0x72, 0x0a, 0x0d, 0x53,
+	0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x12, 0x26, 0x2e, 0x6d,
 	0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
-	0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12, 0x23, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
-	0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00, 0x12, 0x43, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x51, 0x0a, 0x0b,
-	0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x12, 0x24, 0x2e, 0x6d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53,
-	0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x1a,
-	0x13, 0x8a, 0xc4, 0x03, 0x0f, 0x0a, 0x0d, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x42, 0xba, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x79, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x0b, 0x53,
-	0x69, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x75, 0x64, 0x64, 0x65, 0x6e,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x69, 0x6d, 0x70,
-	0x6c, 0x65, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x79, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xca, 0x02, 0x10, 0x4d, 0x79,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xe2, 0x02,
-	0x1c, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11,
-	0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c,
-	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x33, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x92,
+	0xc4, 0x03, 0x0c, 0x0a, 0x08, 0x22, 0x02, 0x08, 0x0a, 0x32, 0x02, 0x20, 0x05, 0x18, 0x01, 0x12,
+	0x50, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x31, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03,
+	0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x12,
+	0x23, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x32, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x32, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xc4, 0x03, 0x00,
+	0x12, 0x43, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x31, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x32, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x04, 0xa2, 0xc4, 0x03, 0x00, 0x12, 0x62, 0x0a, 0x0b, 0x53, 0x6f, 0x6d, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x12, 0x24, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x53, 0x6f, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x31, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x06, 0xaa, 0xc4, 0x03, 0x02, 0x08, 0x01, 0x1a, 0x13, 0x8a, 0xc4,
+	0x03, 0x0f, 0x0a, 0x0d, 0x6d, 0x79, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x42, 0xba, 0x01, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x79, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x2e, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x42, 0x0b, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6c, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xa2,
+	0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x79, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xca, 0x02, 0x10, 0x4d, 0x79, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0xe2, 0x02, 0x1c, 0x4d, 0x79,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5c, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x4d, 0x79, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x3a, 0x3a, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -852,7 +969,7 @@ func file_simple_simple_proto_rawDescGZIP() []byte {
 	return file_simple_simple_proto_rawDescData
 }
 
-var file_simple_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_simple_simple_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_simple_simple_proto_goTypes = []interface{}{
 	(*SomeWorkflow1Request)(nil),                         // 0: mycompany.simple.SomeWorkflow1Request
 	(*SomeWorkflow1Response)(nil),                        // 1: mycompany.simple.SomeWorkflow1Response
@@ -864,37 +981,41 @@ var file_simple_simple_proto_goTypes = []interface{}{
 	(*SomeQuery2Request)(nil),                            // 7: mycompany.simple.SomeQuery2Request
 	(*SomeQuery2Response)(nil),                           // 8: mycompany.simple.SomeQuery2Response
 	(*SomeSignal2Request)(nil),                           // 9: mycompany.simple.SomeSignal2Request
-	(*SomeWorkflow1Request_OuterNested)(nil),             // 10: mycompany.simple.SomeWorkflow1Request.OuterNested
-	(*SomeWorkflow1Request_OuterNested_InnerNested)(nil), // 11: mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
-	(*emptypb.Empty)(nil),                                // 12: google.protobuf.Empty
+	(*SomeUpdate1Request)(nil),                           // 10: mycompany.simple.SomeUpdate1Request
+	(*SomeUpdate1Response)(nil),                          // 11: mycompany.simple.SomeUpdate1Response
+	(*SomeWorkflow1Request_OuterNested)(nil),             // 12: mycompany.simple.SomeWorkflow1Request.OuterNested
+	(*SomeWorkflow1Request_OuterNested_InnerNested)(nil), // 13: mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
+	(*emptypb.Empty)(nil),                                // 14: google.protobuf.Empty
 }
 var file_simple_simple_proto_depIdxs = []int32{
-	10, // 0: mycompany.simple.SomeWorkflow1Request.outer_single:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested
-	10, // 1: mycompany.simple.SomeWorkflow1Request.outer_list:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested
-	11, // 2: mycompany.simple.SomeWorkflow1Request.OuterNested.inner_single:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
-	11, // 3: mycompany.simple.SomeWorkflow1Request.OuterNested.inner_list:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
+	12, // 0: mycompany.simple.SomeWorkflow1Request.outer_single:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested
+	12, // 1: mycompany.simple.SomeWorkflow1Request.outer_list:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested
+	13, // 2: mycompany.simple.SomeWorkflow1Request.OuterNested.inner_single:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
+	13, // 3: mycompany.simple.SomeWorkflow1Request.OuterNested.inner_list:type_name -> mycompany.simple.SomeWorkflow1Request.OuterNested.InnerNested
 	0,  // 4: mycompany.simple.Simple.SomeWorkflow1:input_type -> mycompany.simple.SomeWorkflow1Request
-	12, // 5: mycompany.simple.Simple.SomeWorkflow2:input_type -> google.protobuf.Empty
+	14, // 5: mycompany.simple.Simple.SomeWorkflow2:input_type -> google.protobuf.Empty
 	2,  // 6: mycompany.simple.Simple.SomeWorkflow3:input_type -> mycompany.simple.SomeWorkflow3Request
-	12, // 7: mycompany.simple.Simple.SomeActivity1:input_type -> google.protobuf.Empty
+	14, // 7: mycompany.simple.Simple.SomeActivity1:input_type -> google.protobuf.Empty
 	3,  // 8: mycompany.simple.Simple.SomeActivity2:input_type -> mycompany.simple.SomeActivity2Request
 	4,  // 9: mycompany.simple.Simple.SomeActivity3:input_type -> mycompany.simple.SomeActivity3Request
-	12, // 10: mycompany.simple.Simple.SomeQuery1:input_type -> google.protobuf.Empty
+	14, // 10: mycompany.simple.Simple.SomeQuery1:input_type -> google.protobuf.Empty
 	7,  // 11: mycompany.simple.Simple.SomeQuery2:input_type -> mycompany.simple.SomeQuery2Request
-	12, // 12: mycompany.simple.Simple.SomeSignal1:input_type -> google.protobuf.Empty
+	14, // 12: mycompany.simple.Simple.SomeSignal1:input_type -> google.protobuf.Empty
 	9,  // 13: mycompany.simple.Simple.SomeSignal2:input_type -> mycompany.simple.SomeSignal2Request
-	1,  // 14: mycompany.simple.Simple.SomeWorkflow1:output_type -> mycompany.simple.SomeWorkflow1Response
-	12, // 15: mycompany.simple.Simple.SomeWorkflow2:output_type -> google.protobuf.Empty
-	12, // 16: mycompany.simple.Simple.SomeWorkflow3:output_type -> google.protobuf.Empty
-	12, // 17: mycompany.simple.Simple.SomeActivity1:output_type -> google.protobuf.Empty
-	12, // 18: mycompany.simple.Simple.SomeActivity2:output_type -> google.protobuf.Empty
-	5,  // 19: mycompany.simple.Simple.SomeActivity3:output_type -> mycompany.simple.SomeActivity3Response
-	6,  // 20: mycompany.simple.Simple.SomeQuery1:output_type -> mycompany.simple.SomeQuery1Response
-	8,  // 21: mycompany.simple.Simple.SomeQuery2:output_type -> mycompany.simple.SomeQuery2Response
-	12, // 22: mycompany.simple.Simple.SomeSignal1:output_type -> google.protobuf.Empty
-	12, // 23: mycompany.simple.Simple.SomeSignal2:output_type -> google.protobuf.Empty
-	14, // [14:24] is the sub-list for method output_type
-	4,  // [4:14] is the sub-list for method input_type
+	10, // 14: mycompany.simple.Simple.SomeUpdate1:input_type -> mycompany.simple.SomeUpdate1Request
+	1,  // 15: mycompany.simple.Simple.SomeWorkflow1:output_type -> mycompany.simple.SomeWorkflow1Response
+	14, // 16: mycompany.simple.Simple.SomeWorkflow2:output_type -> google.protobuf.Empty
+	14, // 17: mycompany.simple.Simple.SomeWorkflow3:output_type -> google.protobuf.Empty
+	14, // 18: mycompany.simple.Simple.SomeActivity1:output_type -> google.protobuf.Empty
+	14, // 19: mycompany.simple.Simple.SomeActivity2:output_type -> google.protobuf.Empty
+	5,  // 20: mycompany.simple.Simple.SomeActivity3:output_type -> mycompany.simple.SomeActivity3Response
+	6,  // 21: mycompany.simple.Simple.SomeQuery1:output_type -> mycompany.simple.SomeQuery1Response
+	8,  // 22: mycompany.simple.Simple.SomeQuery2:output_type -> mycompany.simple.SomeQuery2Response
+	14, // 23: mycompany.simple.Simple.SomeSignal1:output_type -> google.protobuf.Empty
+	14, // 24: mycompany.simple.Simple.SomeSignal2:output_type -> google.protobuf.Empty
+	11, // 25: mycompany.simple.Simple.SomeUpdate1:output_type -> mycompany.simple.SomeUpdate1Response
+	15, // [15:26] is the sub-list for method output_type
+	4,  // [4:15] is the sub-list for method input_type
 	4,  // [4:4] is the sub-list for extension type_name
 	4,  // [4:4] is the sub-list for extension extendee
 	0,  // [0:4] is the sub-list for field type_name
@@ -1027,7 +1148,7 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SomeWorkflow1Request_OuterNested); i {
+			switch v := v.(*SomeUpdate1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1039,6 +1160,30 @@ func file_simple_simple_proto_init() {
 			}
 		}
 		file_simple_simple_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SomeUpdate1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simple_simple_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SomeWorkflow1Request_OuterNested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_simple_simple_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SomeWorkflow1Request_OuterNested_InnerNested); i {
 			case 0:
 				return &v.state
@@ -1057,7 +1202,7 @@ func file_simple_simple_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_simple_simple_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
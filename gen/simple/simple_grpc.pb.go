@@ -0,0 +1,504 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: simple/simple.proto
+
+// buf:lint:ignore PACKAGE_DIRECTORY_MATCH
+
+package simple
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Simple_SomeWorkflow1_FullMethodName = "/mycompany.simple.Simple/SomeWorkflow1"
+	Simple_SomeWorkflow2_FullMethodName = "/mycompany.simple.Simple/SomeWorkflow2"
+	Simple_SomeWorkflow3_FullMethodName = "/mycompany.simple.Simple/SomeWorkflow3"
+	Simple_SomeActivity1_FullMethodName = "/mycompany.simple.Simple/SomeActivity1"
+	Simple_SomeActivity2_FullMethodName = "/mycompany.simple.Simple/SomeActivity2"
+	Simple_SomeActivity3_FullMethodName = "/mycompany.simple.Simple/SomeActivity3"
+	Simple_SomeQuery1_FullMethodName    = "/mycompany.simple.Simple/SomeQuery1"
+	Simple_SomeQuery2_FullMethodName    = "/mycompany.simple.Simple/SomeQuery2"
+	Simple_SomeSignal1_FullMethodName   = "/mycompany.simple.Simple/SomeSignal1"
+	Simple_SomeSignal2_FullMethodName   = "/mycompany.simple.Simple/SomeSignal2"
+	Simple_SomeUpdate1_FullMethodName   = "/mycompany.simple.Simple/SomeUpdate1"
+)
+
+// SimpleClient is the client API for Simple service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SimpleClient interface {
+	// SomeWorkflow1 does some workflow thing.
+	SomeWorkflow1(ctx context.Context, in *SomeWorkflow1Request, opts ...grpc.CallOption) (*SomeWorkflow1Response, error)
+	// SomeWorkflow2 does some workflow thing.
+	SomeWorkflow2(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeWorkflow3 does some workflow thing.
+	SomeWorkflow3(ctx context.Context, in *SomeWorkflow3Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeActivity1 does some activity thing.
+	SomeActivity1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeActivity2 does some activity thing.
+	SomeActivity2(ctx context.Context, in *SomeActivity2Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeActivity3 does some activity thing.
+	SomeActivity3(ctx context.Context, in *SomeActivity3Request, opts ...grpc.CallOption) (*SomeActivity3Response, error)
+	// SomeQuery1 queries some thing.
+	SomeQuery1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SomeQuery1Response, error)
+	// SomeQuery2 queries some thing.
+	SomeQuery2(ctx context.Context, in *SomeQuery2Request, opts ...grpc.CallOption) (*SomeQuery2Response, error)
+	// SomeSignal1 is a signal.
+	SomeSignal1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeSignal2 is a signal.
+	SomeSignal2(ctx context.Context, in *SomeSignal2Request, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SomeUpdate1 is an update.
+	SomeUpdate1(ctx context.Context, in *SomeUpdate1Request, opts ...grpc.CallOption) (*SomeUpdate1Response, error)
+}
+
+type simpleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSimpleClient(cc grpc.ClientConnInterface) SimpleClient {
+	return &simpleClient{cc}
+}
+
+func (c *simpleClient) SomeWorkflow1(ctx context.Context, in *SomeWorkflow1Request, opts ...grpc.CallOption) (*SomeWorkflow1Response, error) {
+	out := new(SomeWorkflow1Response)
+	err := c.cc.Invoke(ctx, Simple_SomeWorkflow1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeWorkflow2(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeWorkflow2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeWorkflow3(ctx context.Context, in *SomeWorkflow3Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeWorkflow3_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeActivity1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeActivity1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeActivity2(ctx context.Context, in *SomeActivity2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeActivity2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeActivity3(ctx context.Context, in *SomeActivity3Request, opts ...grpc.CallOption) (*SomeActivity3Response, error) {
+	out := new(SomeActivity3Response)
+	err := c.cc.Invoke(ctx, Simple_SomeActivity3_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeQuery1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SomeQuery1Response, error) {
+	out := new(SomeQuery1Response)
+	err := c.cc.Invoke(ctx, Simple_SomeQuery1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeQuery2(ctx context.Context, in *SomeQuery2Request, opts ...grpc.CallOption) (*SomeQuery2Response, error) {
+	out := new(SomeQuery2Response)
+	err := c.cc.Invoke(ctx, Simple_SomeQuery2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeSignal1(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeSignal1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeSignal2(ctx context.Context, in *SomeSignal2Request, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Simple_SomeSignal2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *simpleClient) SomeUpdate1(ctx context.Context, in *SomeUpdate1Request, opts ...grpc.CallOption) (*SomeUpdate1Response, error) {
+	out := new(SomeUpdate1Response)
+	err := c.cc.Invoke(ctx, Simple_SomeUpdate1_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SimpleServer is the server API for Simple service.
+// All implementations must embed UnimplementedSimpleServer
+// for forward compatibility
+type SimpleServer interface {
+	// SomeWorkflow1 does some workflow thing.
+	SomeWorkflow1(context.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error)
+	// SomeWorkflow2 does some workflow thing.
+	SomeWorkflow2(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// SomeWorkflow3 does some workflow thing.
+	SomeWorkflow3(context.Context, *SomeWorkflow3Request) (*emptypb.Empty, error)
+	// SomeActivity1 does some activity thing.
+	SomeActivity1(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// SomeActivity2 does some activity thing.
+	SomeActivity2(context.Context, *SomeActivity2Request) (*emptypb.Empty, error)
+	// SomeActivity3 does some activity thing.
+	SomeActivity3(context.Context, *SomeActivity3Request) (*SomeActivity3Response, error)
+	// SomeQuery1 queries some thing.
+	SomeQuery1(context.Context, *emptypb.Empty) (*SomeQuery1Response, error)
+	// SomeQuery2 queries some thing.
+	SomeQuery2(context.Context, *SomeQuery2Request) (*SomeQuery2Response, error)
+	// SomeSignal1 is a signal.
+	SomeSignal1(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// SomeSignal2 is a signal.
+	SomeSignal2(context.Context, *SomeSignal2Request) (*emptypb.Empty, error)
+	// SomeUpdate1 is an update.
+	SomeUpdate1(context.Context, *SomeUpdate1Request) (*SomeUpdate1Response, error)
+	mustEmbedUnimplementedSimpleServer()
+}
+
+// UnimplementedSimpleServer must be embedded to have forward compatible implementations.
+type UnimplementedSimpleServer struct {
+}
+
+func (UnimplementedSimpleServer) SomeWorkflow1(context.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeWorkflow1 not implemented")
+}
+func (UnimplementedSimpleServer) SomeWorkflow2(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeWorkflow2 not implemented")
+}
+func (UnimplementedSimpleServer) SomeWorkflow3(context.Context, *SomeWorkflow3Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeWorkflow3 not implemented")
+}
+func (UnimplementedSimpleServer) SomeActivity1(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeActivity1 not implemented")
+}
+func (UnimplementedSimpleServer) SomeActivity2(context.Context, *SomeActivity2Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeActivity2 not implemented")
+}
+func (UnimplementedSimpleServer) SomeActivity3(context.Context, *SomeActivity3Request) (*SomeActivity3Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeActivity3 not implemented")
+}
+func (UnimplementedSimpleServer) SomeQuery1(context.Context, *emptypb.Empty) (*SomeQuery1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeQuery1 not implemented")
+}
+func (UnimplementedSimpleServer) SomeQuery2(context.Context, *SomeQuery2Request) (*SomeQuery2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeQuery2 not implemented")
+}
+func (UnimplementedSimpleServer) SomeSignal1(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeSignal1 not implemented")
+}
+func (UnimplementedSimpleServer) SomeSignal2(context.Context, *SomeSignal2Request) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeSignal2 not implemented")
+}
+func (UnimplementedSimpleServer) SomeUpdate1(context.Context, *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SomeUpdate1 not implemented")
+}
+func (UnimplementedSimpleServer) mustEmbedUnimplementedSimpleServer() {}
+
+// UnsafeSimpleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SimpleServer will
+// result in compilation errors.
+type UnsafeSimpleServer interface {
+	mustEmbedUnimplementedSimpleServer()
+}
+
+func RegisterSimpleServer(s grpc.ServiceRegistrar, srv SimpleServer) {
+	s.RegisterService(&Simple_ServiceDesc, srv)
+}
+
+func _Simple_SomeWorkflow1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeWorkflow1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeWorkflow1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeWorkflow1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeWorkflow1(ctx, req.(*SomeWorkflow1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeWorkflow2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeWorkflow2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeWorkflow2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeWorkflow2(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeWorkflow3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeWorkflow3Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeWorkflow3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeWorkflow3_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeWorkflow3(ctx, req.(*SomeWorkflow3Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeActivity1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeActivity1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeActivity1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeActivity1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeActivity2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeActivity2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeActivity2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeActivity2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeActivity2(ctx, req.(*SomeActivity2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeActivity3_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeActivity3Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeActivity3(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeActivity3_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeActivity3(ctx, req.(*SomeActivity3Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeQuery1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeQuery1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeQuery1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeQuery1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeQuery2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeQuery2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeQuery2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeQuery2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeQuery2(ctx, req.(*SomeQuery2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeSignal1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeSignal1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeSignal1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeSignal1(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeSignal2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeSignal2Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeSignal2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeSignal2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeSignal2(ctx, req.(*SomeSignal2Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Simple_SomeUpdate1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SomeUpdate1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SimpleServer).SomeUpdate1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Simple_SomeUpdate1_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SimpleServer).SomeUpdate1(ctx, req.(*SomeUpdate1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Simple_ServiceDesc is the grpc.ServiceDesc for Simple service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Simple_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mycompany.simple.Simple",
+	HandlerType: (*SimpleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SomeWorkflow1",
+			Handler:    _Simple_SomeWorkflow1_Handler,
+		},
+		{
+			MethodName: "SomeWorkflow2",
+			Handler:    _Simple_SomeWorkflow2_Handler,
+		},
+		{
+			MethodName: "SomeWorkflow3",
+			Handler:    _Simple_SomeWorkflow3_Handler,
+		},
+		{
+			MethodName: "SomeActivity1",
+			Handler:    _Simple_SomeActivity1_Handler,
+		},
+		{
+			MethodName: "SomeActivity2",
+			Handler:    _Simple_SomeActivity2_Handler,
+		},
+		{
+			MethodName: "SomeActivity3",
+			Handler:    _Simple_SomeActivity3_Handler,
+		},
+		{
+			MethodName: "SomeQuery1",
+			Handler:    _Simple_SomeQuery1_Handler,
+		},
+		{
+			MethodName: "SomeQuery2",
+			Handler:    _Simple_SomeQuery2_Handler,
+		},
+		{
+			MethodName: "SomeSignal1",
+			Handler:    _Simple_SomeSignal1_Handler,
+		},
+		{
+			MethodName: "SomeSignal2",
+			Handler:    _Simple_SomeSignal2_Handler,
+		},
+		{
+			MethodName: "SomeUpdate1",
+			Handler:    _Simple_SomeUpdate1_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "simple/simple.proto",
+}
@@ -13,12 +13,17 @@ import (
 	"errors"
 	"fmt"
 	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	grpcutil "github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	replayutil "github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
 	v1 "go.temporal.io/api/enums/v1"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
 	temporal "go.temporal.io/sdk/temporal"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
+	grpc "google.golang.org/grpc"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // SimpleTaskQueue is the default task-queue for a Simple worker
@@ -34,7 +39,7 @@ const (
 // Simple id expressions
 var (
 	SomeWorkflow1IDExpression = expression.MustParseExpression("some-workflow-1/${!id}/${!uuid_v4()}")
-	SomeWorkflow3IDExpression = expression.MustParseExpression("some-workflow-3/${!id}/${!request_val}")
+	SomeWorkflow3IDExpression = expression.MustParseExpression("some-workflow-3/${!id}/${!requestVal}")
 )
 
 // Simple query names
@@ -49,6 +54,16 @@ const (
 	SomeSignal2SignalName = "mycompany.simple.Simple.SomeSignal2Signal"
 )
 
+// Simple update names
+const (
+	SomeUpdate1UpdateName = "mycompany.simple.Simple.SomeUpdate1Update"
+)
+
+// Simple patch change ids
+const (
+	SomeWorkflow1PatchV2BillingChangeID = "v2-billing"
+)
+
 // Simple activity names
 const (
 	SomeActivity1ActivityName = "mycompany.simple.SomeActivity1"
@@ -56,6 +71,11 @@ const (
 	SomeActivity3ActivityName = "mycompany.simple.Simple.SomeActivity3Activity"
 )
 
+// Simple activity id expressions
+var (
+	SomeActivity2ActivityIDExpression = expression.MustParseExpression("some-activity-2/${!requestVal}")
+)
+
 // Client describes a client for a Simple worker
 type Client interface {
 	// SomeWorkflow1 does some workflow thing.
@@ -80,6 +100,10 @@ type Client interface {
 	GetSomeWorkflow3(ctx context.Context, workflowID string, runID string) (SomeWorkflow3Run, error)
 	// StartSomeWorkflow3WithSomeSignal2 sends a SomeSignal2 signal to a SomeWorkflow3 workflow, starting it if not present
 	StartSomeWorkflow3WithSomeSignal2(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request, signal *SomeSignal2Request) (SomeWorkflow3Run, error)
+	// CreateSomeWorkflow3Schedule creates a schedule that periodically starts SomeWorkflow3 workflows
+	CreateSomeWorkflow3Schedule(ctx context.Context, schedule *client.ScheduleOptions, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3ScheduleHandle, error)
+	// GetSomeWorkflow3Schedule retrieves an existing SomeWorkflow3 schedule
+	GetSomeWorkflow3Schedule(ctx context.Context, scheduleID string) (SomeWorkflow3ScheduleHandle, error)
 	// QuerySomeQuery1 sends a SomeQuery1 query to an existing workflow
 	QuerySomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error)
 	// QuerySomeQuery2 sends a SomeQuery2 query to an existing workflow
@@ -88,6 +112,8 @@ type Client interface {
 	SignalSomeSignal1(ctx context.Context, workflowID string, runID string) error
 	// SignalSomeSignal2 sends a SomeSignal2 signal to an existing workflow
 	SignalSomeSignal2(ctx context.Context, workflowID string, runID string, signal *SomeSignal2Request) error
+	// UpdateSomeUpdate1 sends a SomeUpdate1 update to an existing workflow
+	UpdateSomeUpdate1(ctx context.Context, workflowID string, runID string, update *SomeUpdate1Request) (*SomeUpdate1Response, error)
 }
 
 // Compile-time check that workflowClient satisfies Client
@@ -127,9 +153,6 @@ func (c *workflowClient) ExecuteSomeWorkflow1(ctx context.Context, opts *client.
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
 		if err != nil {
@@ -137,6 +160,9 @@ func (c *workflowClient) ExecuteSomeWorkflow1(ctx context.Context, opts *client.
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue"
+	}
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow1WorkflowName, req)
 	if err != nil {
 		return nil, err
@@ -228,9 +254,6 @@ func (c *workflowClient) ExecuteSomeWorkflow3(ctx context.Context, opts *client.
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
 		if err != nil {
@@ -238,6 +261,9 @@ func (c *workflowClient) ExecuteSomeWorkflow3(ctx context.Context, opts *client.
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
+	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
@@ -245,7 +271,13 @@ func (c *workflowClient) ExecuteSomeWorkflow3(ctx context.Context, opts *client.
 		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	if opts.WorkflowRunTimeout == 0 {
+		opts.WorkflowRunTimeout = 1800000000000 // 30m0s
+	}
+	if opts.WorkflowTaskTimeout == 0 {
+		opts.WorkflowTaskTimeout = 30000000000 // 30s
 	}
 	run, err := c.client.ExecuteWorkflow(ctx, *opts, SomeWorkflow3WorkflowName, req)
 	if err != nil {
@@ -273,9 +305,6 @@ func (c *workflowClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context,
 	if opts == nil {
 		opts = &client.StartWorkflowOptions{}
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
-	}
 	if opts.ID == "" {
 		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
 		if err != nil {
@@ -283,6 +312,9 @@ func (c *workflowClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context,
 		}
 		opts.ID = id
 	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
+	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
 	}
@@ -290,7 +322,13 @@ func (c *workflowClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context,
 		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	if opts.WorkflowRunTimeout == 0 {
+		opts.WorkflowRunTimeout = 1800000000000 // 30m0s
+	}
+	if opts.WorkflowTaskTimeout == 0 {
+		opts.WorkflowTaskTimeout = 30000000000 // 30s
 	}
 	run, err := c.client.SignalWithStartWorkflow(ctx, opts.ID, SomeSignal2SignalName, signal, *opts, SomeWorkflow3WorkflowName, req)
 	if run == nil || err != nil {
@@ -302,6 +340,82 @@ func (c *workflowClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context,
 	}, nil
 }
 
+// CreateSomeWorkflow3Schedule creates a schedule that periodically starts SomeWorkflow3 workflows
+func (c *workflowClient) CreateSomeWorkflow3Schedule(ctx context.Context, schedule *client.ScheduleOptions, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3ScheduleHandle, error) {
+	if schedule == nil {
+		schedule = &client.ScheduleOptions{PauseOnFailure: true}
+	}
+	if len(schedule.Spec.Calendars) == 0 && len(schedule.Spec.Intervals) == 0 && len(schedule.Spec.CronExpressions) == 0 {
+		schedule.Spec.Intervals = []client.ScheduleIntervalSpec{
+			{Every: 3600000000000},
+		}
+	}
+	if schedule.Spec.Jitter == 0 {
+		schedule.Spec.Jitter = 60000000000 // 1m0s
+	}
+	if schedule.Overlap == v1.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		schedule.Overlap = v1.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	if schedule.CatchupWindow == 0 {
+		schedule.CatchupWindow = 600000000000 // 10m0s
+	}
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
+	}
+	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	}
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	if opts.WorkflowRunTimeout == 0 {
+		opts.WorkflowRunTimeout = 1800000000000 // 30m0s
+	}
+	if opts.WorkflowTaskTimeout == 0 {
+		opts.WorkflowTaskTimeout = 30000000000 // 30s
+	}
+	if schedule.ID == "" {
+		schedule.ID = opts.ID
+	}
+	if schedule.Action == nil {
+		schedule.Action = &client.ScheduleWorkflowAction{
+			Args:                     []interface{}{req},
+			ID:                       opts.ID,
+			Memo:                     opts.Memo,
+			RetryPolicy:              opts.RetryPolicy,
+			SearchAttributes:         opts.SearchAttributes,
+			TaskQueue:                opts.TaskQueue,
+			Workflow:                 SomeWorkflow3WorkflowName,
+			WorkflowExecutionTimeout: opts.WorkflowExecutionTimeout,
+			WorkflowRunTimeout:       opts.WorkflowRunTimeout,
+			WorkflowTaskTimeout:      opts.WorkflowTaskTimeout,
+		}
+	}
+	handle, err := c.client.ScheduleClient().Create(ctx, *schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &someWorkflow3ScheduleHandle{ScheduleHandle: handle, client: c}, nil
+}
+
+// GetSomeWorkflow3Schedule retrieves an existing SomeWorkflow3 schedule
+func (c *workflowClient) GetSomeWorkflow3Schedule(ctx context.Context, scheduleID string) (SomeWorkflow3ScheduleHandle, error) {
+	return &someWorkflow3ScheduleHandle{ScheduleHandle: c.client.ScheduleClient().GetHandle(ctx, scheduleID), client: c}, nil
+}
+
 // QuerySomeQuery1 sends a SomeQuery1 query to an existing workflow
 func (c *workflowClient) QuerySomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
 	var resp SomeQuery1Response
@@ -334,6 +448,19 @@ func (c *workflowClient) SignalSomeSignal2(ctx context.Context, workflowID strin
 	return c.client.SignalWorkflow(ctx, workflowID, runID, SomeSignal2SignalName, signal)
 }
 
+// UpdateSomeUpdate1 sends a SomeUpdate1 update to an existing workflow
+func (c *workflowClient) UpdateSomeUpdate1(ctx context.Context, workflowID string, runID string, update *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	handle, err := c.client.UpdateWorkflow(ctx, workflowID, runID, SomeUpdate1UpdateName, update)
+	if err != nil {
+		return nil, err
+	}
+	var resp SomeUpdate1Response
+	if err := handle.Get(ctx, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // SomeWorkflow1Run describes a SomeWorkflow1 workflow run
 type SomeWorkflow1Run interface {
 	// ID returns the workflow ID
@@ -350,6 +477,8 @@ type SomeWorkflow1Run interface {
 	SomeSignal1(ctx context.Context) error
 	// SomeSignal2 sends a SomeSignal2 signal to the workflow
 	SomeSignal2(ctx context.Context, req *SomeSignal2Request) error
+	// SomeUpdate1 sends a SomeUpdate1 update to the workflow
+	SomeUpdate1(ctx context.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error)
 }
 
 // someWorkflow1Run provides an internal implementation of a SomeWorkflow1Run
@@ -397,6 +526,11 @@ func (r *someWorkflow1Run) SomeSignal2(ctx context.Context, req *SomeSignal2Requ
 	return r.client.SignalSomeSignal2(ctx, r.ID(), "", req)
 }
 
+// SomeUpdate1 sends a SomeUpdate1 update to the workflow
+func (r *someWorkflow1Run) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	return r.client.UpdateSomeUpdate1(ctx, r.ID(), "", req)
+}
+
 // SomeWorkflow2Run describes a SomeWorkflow2 workflow run
 type SomeWorkflow2Run interface {
 	// ID returns the workflow ID
@@ -473,6 +607,37 @@ func (r *someWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2Requ
 	return r.client.SignalSomeSignal2(ctx, r.ID(), "", req)
 }
 
+// SomeWorkflow3ScheduleHandle describes a schedule that periodically starts SomeWorkflow3 workflows
+type SomeWorkflow3ScheduleHandle interface {
+	client.ScheduleHandle
+
+	// RunningWorkflows returns the SomeWorkflow3 workflows started by the schedule that are still running
+	RunningWorkflows(ctx context.Context) ([]SomeWorkflow3Run, error)
+}
+
+// someWorkflow3ScheduleHandle provides an internal implementation of a SomeWorkflow3ScheduleHandle
+type someWorkflow3ScheduleHandle struct {
+	client.ScheduleHandle
+	client *workflowClient
+}
+
+// RunningWorkflows returns the SomeWorkflow3 workflows started by the schedule that are still running
+func (h *someWorkflow3ScheduleHandle) RunningWorkflows(ctx context.Context) ([]SomeWorkflow3Run, error) {
+	desc, err := h.Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]SomeWorkflow3Run, 0, len(desc.Info.RunningWorkflows))
+	for _, execution := range desc.Info.RunningWorkflows {
+		run, err := h.client.GetSomeWorkflow3(ctx, execution.WorkflowID, "")
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
 // Workflows provides methods for initializing new Simple workflow values
 type Workflows interface {
 	// SomeWorkflow1 initializes a new SomeWorkflow1Workflow value
@@ -490,13 +655,28 @@ func RegisterWorkflows(r worker.Registry, workflows Workflows) {
 	RegisterSomeWorkflow3Workflow(r, workflows.SomeWorkflow3)
 }
 
+// NewSimpleReplayer initializes a new workflow replayer with all Simple workflows registered
+func NewSimpleReplayer(workflows Workflows) worker.WorkflowReplayer {
+	r := worker.NewWorkflowReplayer()
+	r.RegisterWorkflowWithOptions(buildSomeWorkflow1(workflows.SomeWorkflow1), workflow.RegisterOptions{Name: SomeWorkflow1WorkflowName})
+	r.RegisterWorkflowWithOptions(buildSomeWorkflow2(workflows.SomeWorkflow2), workflow.RegisterOptions{Name: SomeWorkflow2WorkflowName})
+	r.RegisterWorkflowWithOptions(buildSomeWorkflow3(workflows.SomeWorkflow3), workflow.RegisterOptions{Name: SomeWorkflow3WorkflowName})
+	return r
+}
+
+// ReplaySimpleHistories replays every JSON workflow history in the given directory against the
+// given Simple workflows, returning an error if any history fails to replay
+func ReplaySimpleHistories(workflows Workflows, dir string) error {
+	return replayutil.ReplayDir(NewSimpleReplayer(workflows), nil, dir)
+}
+
 // RegisterSomeWorkflow1Workflow registers a SomeWorkflow1 workflow with the given worker
 func RegisterSomeWorkflow1Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)) {
 	r.RegisterWorkflowWithOptions(buildSomeWorkflow1(wf), workflow.RegisterOptions{Name: SomeWorkflow1WorkflowName})
 }
 
 // buildSomeWorkflow1 converts a SomeWorkflow1 workflow struct into a valid workflow function
-func buildSomeWorkflow1(wf func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)) func(workflow.Context, *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+func buildSomeWorkflow1(wf func(workflow.Context, *SomeWorkflow1Input) (SomeWorkflow1Workflow, error)) func(workflow.Context, *SomeWorkflow1Request, map[string][]*anypb.Any) (*SomeWorkflow1Response, error) {
 	return (&someWorkflow1{wf}).SomeWorkflow1
 }
 
@@ -506,7 +686,7 @@ type someWorkflow1 struct {
 }
 
 // SomeWorkflow1 constructs a new SomeWorkflow1 value and executes it
-func (w *someWorkflow1) SomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+func (w *someWorkflow1) SomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Request, carried map[string][]*anypb.Any) (*SomeWorkflow1Response, error) {
 	input := &SomeWorkflow1Input{
 		Req: req,
 		SomeSignal1: &SomeSignal1Signal{
@@ -516,6 +696,12 @@ func (w *someWorkflow1) SomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Re
 			Channel: workflow.GetSignalChannel(ctx, SomeSignal2SignalName),
 		},
 	}
+	if err := input.SomeSignal1.restore(ctx, carried[SomeSignal1SignalName]); err != nil {
+		return nil, err
+	}
+	if err := input.SomeSignal2.restore(ctx, carried[SomeSignal2SignalName]); err != nil {
+		return nil, err
+	}
 	wf, err := w.ctor(ctx, input)
 	if err != nil {
 		return nil, err
@@ -526,7 +712,22 @@ func (w *someWorkflow1) SomeWorkflow1(ctx workflow.Context, req *SomeWorkflow1Re
 	if err := workflow.SetQueryHandler(ctx, SomeQuery2QueryName, wf.SomeQuery2); err != nil {
 		return nil, err
 	}
-	return wf.Execute(ctx)
+	if err := workflow.SetUpdateHandlerWithOptions(ctx, SomeUpdate1UpdateName, wf.SomeUpdate1, workflow.UpdateHandlerOptions{Validator: wf.ValidateSomeUpdate1}); err != nil {
+		return nil, err
+	}
+	resp, err := wf.Execute(ctx)
+	if !errors.Is(err, errSomeWorkflow1ContinueAsNew) {
+		return resp, err
+	}
+	carried = make(map[string][]*anypb.Any)
+	if carried[SomeSignal1SignalName], err = input.SomeSignal1.drain(ctx); err != nil {
+		return nil, err
+	}
+	if carried[SomeSignal2SignalName], err = input.SomeSignal2.drain(ctx); err != nil {
+		return nil, err
+	}
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return nil, workflow.NewContinueAsNewError(ctx, SomeWorkflow1WorkflowName, input.Req, carried)
 }
 
 // SomeWorkflow1Input describes the input to a SomeWorkflow1 workflow constructor
@@ -536,6 +737,20 @@ type SomeWorkflow1Input struct {
 	SomeSignal2 *SomeSignal2Signal
 }
 
+// errSomeWorkflow1ContinueAsNew is returned by SomeWorkflow1Input.ContinueAsNew to request that the workflow continue as new
+var errSomeWorkflow1ContinueAsNew = errors.New("continue as new")
+
+// ShouldContinueAsNew returns true once the SomeWorkflow1 workflow history reaches 10000 events
+func (i *SomeWorkflow1Input) ShouldContinueAsNew(ctx workflow.Context) bool {
+	return workflow.GetInfo(ctx).GetCurrentHistoryLength() >= 10000
+}
+
+// ContinueAsNew returns an error that, when returned by Execute, continues the workflow as new
+// with the current Req, carrying over any pending signals
+func (i *SomeWorkflow1Input) ContinueAsNew() error {
+	return errSomeWorkflow1ContinueAsNew
+}
+
 // SomeWorkflow1 does some workflow thing.
 type SomeWorkflow1Workflow interface {
 	// Execute a SomeWorkflow1 workflow
@@ -544,23 +759,48 @@ type SomeWorkflow1Workflow interface {
 	SomeQuery1() (*SomeQuery1Response, error)
 	// SomeQuery2 query handler
 	SomeQuery2(*SomeQuery2Request) (*SomeQuery2Response, error)
+	// SomeUpdate1 update handler
+	SomeUpdate1(workflow.Context, *SomeUpdate1Request) (*SomeUpdate1Response, error)
+	// ValidateSomeUpdate1 validates a SomeUpdate1 update before it is accepted
+	ValidateSomeUpdate1(workflow.Context, *SomeUpdate1Request) error
 }
 
 // SomeWorkflow1Child executes a child SomeWorkflow1 workflow
 func SomeWorkflow1Child(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *SomeWorkflow1Request) *SomeWorkflow1ChildRun {
 	if opts == nil {
 		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "my-task-queue"
 		opts = &childOpts
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue"
-	}
 	if opts.WorkflowID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
-		if err != nil {
-			panic(err)
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
+			if err != nil {
+				return &SomeWorkflow1ChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &SomeWorkflow1ChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &SomeWorkflow1ChildRun{Future: newFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
 		}
-		opts.WorkflowID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue"
 	}
 	ctx = workflow.WithChildOptions(ctx, *opts)
 	return &SomeWorkflow1ChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SomeWorkflow1WorkflowName, req)}
@@ -617,6 +857,18 @@ func (r *SomeWorkflow1ChildRun) SomeSignal2(ctx workflow.Context, input *SomeSig
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal2SignalName, input)
 }
 
+// SomeWorkflow1ContinueAsNew returns an error that instructs the current workflow to continue as a new SomeWorkflow1 workflow,
+// applying the default task queue and timeouts
+func SomeWorkflow1ContinueAsNew(ctx workflow.Context, req *SomeWorkflow1Request) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, SomeWorkflow1WorkflowName, req)
+}
+
+// SomeWorkflow1PatchV2Billing returns true if the v2-billing patch applies to the current SomeWorkflow1 workflow execution
+func SomeWorkflow1PatchV2Billing(ctx workflow.Context) bool {
+	return workflow.GetVersion(ctx, SomeWorkflow1PatchV2BillingChangeID, workflow.DefaultVersion, 1) == 1
+}
+
 // RegisterSomeWorkflow2Workflow registers a SomeWorkflow2 workflow with the given worker
 func RegisterSomeWorkflow2Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow2Input) (SomeWorkflow2Workflow, error)) {
 	r.RegisterWorkflowWithOptions(buildSomeWorkflow2(wf), workflow.RegisterOptions{Name: SomeWorkflow2WorkflowName})
@@ -661,6 +913,7 @@ type SomeWorkflow2Workflow interface {
 func SomeWorkflow2Child(ctx workflow.Context, opts *workflow.ChildWorkflowOptions) *SomeWorkflow2ChildRun {
 	if opts == nil {
 		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "my-task-queue"
 		opts = &childOpts
 	}
 	if opts.TaskQueue == "" {
@@ -715,6 +968,13 @@ func (r *SomeWorkflow2ChildRun) SomeSignal1(ctx workflow.Context) workflow.Futur
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal1SignalName, nil)
 }
 
+// SomeWorkflow2ContinueAsNew returns an error that instructs the current workflow to continue as a new SomeWorkflow2 workflow,
+// applying the default task queue and timeouts
+func SomeWorkflow2ContinueAsNew(ctx workflow.Context) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, SomeWorkflow2WorkflowName)
+}
+
 // RegisterSomeWorkflow3Workflow registers a SomeWorkflow3 workflow with the given worker
 func RegisterSomeWorkflow3Workflow(r worker.Registry, wf func(workflow.Context, *SomeWorkflow3Input) (SomeWorkflow3Workflow, error)) {
 	r.RegisterWorkflowWithOptions(buildSomeWorkflow3(wf), workflow.RegisterOptions{Name: SomeWorkflow3WorkflowName})
@@ -761,17 +1021,43 @@ type SomeWorkflow3Workflow interface {
 func SomeWorkflow3Child(ctx workflow.Context, opts *workflow.ChildWorkflowOptions, req *SomeWorkflow3Request) *SomeWorkflow3ChildRun {
 	if opts == nil {
 		childOpts := workflow.GetChildWorkflowOptions(ctx)
+		childOpts.TaskQueue = "my-task-queue-2"
+		childOpts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+		childOpts.WorkflowRunTimeout = 1800000000000       // 30m0s
+		childOpts.WorkflowTaskTimeout = 30000000000        // 30s
+		childOpts.Namespace = "my-namespace"
+		childOpts.WaitForCancellation = true
 		opts = &childOpts
 	}
-	if opts.TaskQueue == "" {
-		opts.TaskQueue = "my-task-queue-2"
-	}
 	if opts.WorkflowID == "" {
-		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
-		if err != nil {
-			panic(err)
+		if workflow.GetVersion(ctx, "protoc-gen-go-temporal/child-id-side-effect", workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+			id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+			if err != nil {
+				return &SomeWorkflow3ChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			opts.WorkflowID = id
+		} else {
+			var id struct {
+				Value, Err string
+			}
+			if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+				value, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+				if err != nil {
+					id.Err = err.Error()
+				}
+				id.Value = value
+				return id
+			}).Get(&id); err != nil {
+				return &SomeWorkflow3ChildRun{Future: newFailedChildFuture(ctx, err)}
+			}
+			if id.Err != "" {
+				return &SomeWorkflow3ChildRun{Future: newFailedChildFuture(ctx, errors.New(id.Err))}
+			}
+			opts.WorkflowID = id.Value
 		}
-		opts.WorkflowID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
 	}
 	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
 		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
@@ -780,7 +1066,19 @@ func SomeWorkflow3Child(ctx workflow.Context, opts *workflow.ChildWorkflowOption
 		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
 	}
 	if opts.WorkflowExecutionTimeout == 0 {
-		opts.WorkflowRunTimeout = 3600000000000 // 1h0m0s
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	if opts.WorkflowRunTimeout == 0 {
+		opts.WorkflowRunTimeout = 1800000000000 // 30m0s
+	}
+	if opts.WorkflowTaskTimeout == 0 {
+		opts.WorkflowTaskTimeout = 30000000000 // 30s
+	}
+	if opts.Namespace == "" {
+		opts.Namespace = "my-namespace"
+	}
+	if opts.ParentClosePolicy == v1.PARENT_CLOSE_POLICY_UNSPECIFIED {
+		opts.ParentClosePolicy = v1.PARENT_CLOSE_POLICY_ABANDON
 	}
 	ctx = workflow.WithChildOptions(ctx, *opts)
 	return &SomeWorkflow3ChildRun{Future: workflow.ExecuteChildWorkflow(ctx, SomeWorkflow3WorkflowName, req)}
@@ -831,6 +1129,37 @@ func (r *SomeWorkflow3ChildRun) SomeSignal2(ctx workflow.Context, input *SomeSig
 	return r.Future.SignalChildWorkflow(ctx, SomeSignal2SignalName, input)
 }
 
+// SomeWorkflow3ContinueAsNew returns an error that instructs the current workflow to continue as a new SomeWorkflow3 workflow,
+// applying the default task queue and timeouts
+func SomeWorkflow3ContinueAsNew(ctx workflow.Context, req *SomeWorkflow3Request) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue-2")
+	ctx = workflow.WithWorkflowRunTimeout(ctx, 1800000000000) // 30m0s
+	ctx = workflow.WithWorkflowTaskTimeout(ctx, 30000000000)  // 30s
+	return workflow.NewContinueAsNewError(ctx, SomeWorkflow3WorkflowName, req)
+}
+
+// failedChildFuture describes a child workflow future that failed before the child workflow was started
+type failedChildFuture struct {
+	workflow.Future
+}
+
+// newFailedChildFuture returns a child workflow future that has already failed with the given error
+func newFailedChildFuture(ctx workflow.Context, err error) *failedChildFuture {
+	future, settable := workflow.NewFuture(ctx)
+	settable.SetError(err)
+	return &failedChildFuture{Future: future}
+}
+
+// GetChildWorkflowExecution returns the failed future
+func (f *failedChildFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+// SignalChildWorkflow returns the failed future
+func (f *failedChildFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
 // SomeSignal1Signal describes a SomeSignal1 signal
 type SomeSignal1Signal struct {
 	Channel workflow.ReceiveChannel
@@ -862,6 +1191,45 @@ func SomeSignal1External(ctx workflow.Context, workflowID string, runID string)
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, SomeSignal1SignalName, nil)
 }
 
+// restore delivers SomeSignal1 signals carried over from a previous run ahead of any newly received signals
+func (s *SomeSignal1Signal) restore(ctx workflow.Context, carried []*anypb.Any) error {
+	if len(carried) == 0 {
+		return nil
+	}
+	ch := workflow.NewBufferedChannel(ctx, len(carried))
+	for range carried {
+		ch.SendAsync(nil)
+	}
+	signals := s.Channel
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			signals.Receive(ctx, nil)
+			ch.Send(ctx, nil)
+		}
+	})
+	s.Channel = ch
+	return nil
+}
+
+// drain receives all pending SomeSignal1 signals without blocking so that they can be carried over to a new run
+func (s *SomeSignal1Signal) drain(ctx workflow.Context) ([]*anypb.Any, error) {
+	var carried []*anypb.Any
+	// restored signals may still be buffered on the underlying signal channel
+	for _, ch := range []workflow.ReceiveChannel{s.Channel, workflow.GetSignalChannel(ctx, SomeSignal1SignalName)} {
+		for {
+			if !ch.ReceiveAsync(nil) {
+				break
+			}
+			v, err := anypb.New(&emptypb.Empty{})
+			if err != nil {
+				return nil, fmt.Errorf("error draining %s signal: %w", SomeSignal1SignalName, err)
+			}
+			carried = append(carried, v)
+		}
+	}
+	return carried, nil
+}
+
 // SomeSignal2Signal describes a SomeSignal2 signal
 type SomeSignal2Signal struct {
 	Channel workflow.ReceiveChannel
@@ -898,6 +1266,51 @@ func SomeSignal2External(ctx workflow.Context, workflowID string, runID string,
 	return workflow.SignalExternalWorkflow(ctx, workflowID, runID, SomeSignal2SignalName, req)
 }
 
+// restore delivers SomeSignal2 signals carried over from a previous run ahead of any newly received signals
+func (s *SomeSignal2Signal) restore(ctx workflow.Context, carried []*anypb.Any) error {
+	if len(carried) == 0 {
+		return nil
+	}
+	ch := workflow.NewBufferedChannel(ctx, len(carried))
+	for _, v := range carried {
+		var req SomeSignal2Request
+		if err := v.UnmarshalTo(&req); err != nil {
+			return fmt.Errorf("error restoring %s signal: %w", SomeSignal2SignalName, err)
+		}
+		ch.SendAsync(&req)
+	}
+	signals := s.Channel
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			var req SomeSignal2Request
+			signals.Receive(ctx, &req)
+			ch.Send(ctx, &req)
+		}
+	})
+	s.Channel = ch
+	return nil
+}
+
+// drain receives all pending SomeSignal2 signals without blocking so that they can be carried over to a new run
+func (s *SomeSignal2Signal) drain(ctx workflow.Context) ([]*anypb.Any, error) {
+	var carried []*anypb.Any
+	// restored signals may still be buffered on the underlying signal channel
+	for _, ch := range []workflow.ReceiveChannel{s.Channel, workflow.GetSignalChannel(ctx, SomeSignal2SignalName)} {
+		for {
+			var req SomeSignal2Request
+			if !ch.ReceiveAsync(&req) {
+				break
+			}
+			v, err := anypb.New(&req)
+			if err != nil {
+				return nil, fmt.Errorf("error draining %s signal: %w", SomeSignal2SignalName, err)
+			}
+			carried = append(carried, v)
+		}
+	}
+	return carried, nil
+}
+
 // Activities describes available worker activites
 type Activities interface {
 	// SomeActivity1 does some activity thing.
@@ -951,22 +1364,6 @@ func SomeActivity1(ctx workflow.Context, opts *workflow.ActivityOptions) *SomeAc
 	return &SomeActivity1Future{Future: workflow.ExecuteActivity(ctx, SomeActivity1ActivityName)}
 }
 
-// SomeActivity1 does some activity thing.
-func SomeActivity1Local(ctx workflow.Context, opts *workflow.LocalActivityOptions, fn func(context.Context) error) *SomeActivity1Future {
-	if opts == nil {
-		activityOpts := workflow.GetLocalActivityOptions(ctx)
-		opts = &activityOpts
-	}
-	ctx = workflow.WithLocalActivityOptions(ctx, *opts)
-	var activity any
-	if fn == nil {
-		activity = SomeActivity1ActivityName
-	} else {
-		activity = fn
-	}
-	return &SomeActivity1Future{Future: workflow.ExecuteLocalActivity(ctx, activity)}
-}
-
 // RegisterSomeActivity2Activity registers a SomeActivity2 activity
 func RegisterSomeActivity2Activity(r worker.Registry, fn func(context.Context, *SomeActivity2Request) error) {
 	r.RegisterActivityWithOptions(fn, activity.RegisterOptions{
@@ -997,8 +1394,37 @@ func (f *SomeActivity2Future) Select(sel workflow.Selector, fn func(*SomeActivit
 func SomeActivity2(ctx workflow.Context, opts *workflow.ActivityOptions, req *SomeActivity2Request) *SomeActivity2Future {
 	if opts == nil {
 		activityOpts := workflow.GetActivityOptions(ctx)
+		activityOpts.TaskQueue = "some-activity-2"
+		activityOpts.WaitForCancellation = true
+		activityOpts.DisableEagerExecution = true
 		opts = &activityOpts
 	}
+	if opts.ActivityID == "" {
+		var id struct {
+			Value, Err string
+		}
+		if err := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+			value, err := expression.EvalExpression(SomeActivity2ActivityIDExpression, req.ProtoReflect())
+			if err != nil {
+				id.Err = err.Error()
+			}
+			id.Value = value
+			return id
+		}).Get(&id); err != nil {
+			future, settable := workflow.NewFuture(ctx)
+			settable.SetError(err)
+			return &SomeActivity2Future{Future: future}
+		}
+		if id.Err != "" {
+			future, settable := workflow.NewFuture(ctx)
+			settable.SetError(errors.New(id.Err))
+			return &SomeActivity2Future{Future: future}
+		}
+		opts.ActivityID = id.Value
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "some-activity-2"
+	}
 	if opts.RetryPolicy == nil {
 		opts.RetryPolicy = &temporal.RetryPolicy{MaximumInterval: 30000000000}
 	}
@@ -1016,7 +1442,10 @@ func SomeActivity2Local(ctx workflow.Context, opts *workflow.LocalActivityOption
 		opts = &activityOpts
 	}
 	if opts.RetryPolicy == nil {
-		opts.RetryPolicy = &temporal.RetryPolicy{MaximumInterval: 30000000000}
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(3)}
+	}
+	if opts.ScheduleToCloseTimeout == 0 {
+		opts.ScheduleToCloseTimeout = 5000000000 // 5s
 	}
 	if opts.StartToCloseTimeout == 0 {
 		opts.StartToCloseTimeout = 10000000000 // 10s
@@ -1098,3 +1527,168 @@ func SomeActivity3Local(ctx workflow.Context, opts *workflow.LocalActivityOption
 	}
 	return &SomeActivity3Future{Future: workflow.ExecuteLocalActivity(ctx, activity, req)}
 }
+
+// Compile-time check that remoteActivities satisfies Activities
+var _ Activities = &remoteActivities{}
+
+// remoteActivities implements Activities by invoking remote activities on a gRPC connection
+// and delegating all other activities to the embedded implementation
+type remoteActivities struct {
+	Activities
+	conn grpc.ClientConnInterface
+}
+
+// NewRemoteActivities initializes a new Activities value that executes remote activities by
+// invoking the corresponding unary rpc on the given connection, delegating all other activities
+// to local, which may be nil if every activity is remote
+func NewRemoteActivities(conn grpc.ClientConnInterface, local Activities) Activities {
+	return &remoteActivities{Activities: local, conn: conn}
+}
+
+// SomeActivity3 invokes the remote /mycompany.simple.Simple/SomeActivity3 rpc
+func (a *remoteActivities) SomeActivity3(ctx context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error) {
+	resp := &SomeActivity3Response{}
+	if err := grpcutil.InvokeActivity(ctx, a.conn, "/mycompany.simple.Simple/SomeActivity3", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Compile-time check that workflowServer satisfies SimpleServer
+var _ SimpleServer = &workflowServer{}
+
+// workflowServer implements a SimpleServer that forwards requests to a Simple client
+type workflowServer struct {
+	UnimplementedSimpleServer
+	client Client
+}
+
+// NewServer initializes a new SimpleServer that forwards requests to the given Simple client. Target
+// workflow and run ids are read from incoming gRPC metadata, falling back to the default id
+// expression when starting workflows
+func NewServer(c Client) SimpleServer {
+	return &workflowServer{client: c}
+}
+
+// SomeWorkflow1 does some workflow thing.
+func (s *workflowServer) SomeWorkflow1(ctx context.Context, req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	resp, err := s.client.SomeWorkflow1(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// SomeWorkflow2 does some workflow thing.
+func (s *workflowServer) SomeWorkflow2(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.client.SomeWorkflow2(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SomeWorkflow3 does some workflow thing.
+func (s *workflowServer) SomeWorkflow3(ctx context.Context, req *SomeWorkflow3Request) (*emptypb.Empty, error) {
+	if err := s.client.SomeWorkflow3(ctx, &client.StartWorkflowOptions{ID: grpcutil.WorkflowID(ctx)}, req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SomeQuery1 queries some thing.
+func (s *workflowServer) SomeQuery1(ctx context.Context, req *emptypb.Empty) (*SomeQuery1Response, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.QuerySomeQuery1(ctx, workflowID, grpcutil.RunID(ctx))
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// SomeQuery2 queries some thing.
+func (s *workflowServer) SomeQuery2(ctx context.Context, req *SomeQuery2Request) (*SomeQuery2Response, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.QuerySomeQuery2(ctx, workflowID, grpcutil.RunID(ctx), req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// SomeSignal1 is a signal.
+func (s *workflowServer) SomeSignal1(ctx context.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalSomeSignal1(ctx, workflowID, grpcutil.RunID(ctx)); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SomeSignal2 is a signal.
+func (s *workflowServer) SomeSignal2(ctx context.Context, req *SomeSignal2Request) (*emptypb.Empty, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.client.SignalSomeSignal2(ctx, workflowID, grpcutil.RunID(ctx), req); err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// SomeUpdate1 is an update.
+func (s *workflowServer) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.UpdateSomeUpdate1(ctx, workflowID, grpcutil.RunID(ctx), req)
+	if err != nil {
+		return nil, grpcutil.ToStatusError(err)
+	}
+	return resp, nil
+}
+
+// Compile-time check that serverActivities satisfies Activities
+var _ Activities = &serverActivities{}
+
+// serverActivities implements Activities by calling a SimpleServer
+type serverActivities struct {
+	server SimpleServer
+}
+
+// NewActivitiesFromServer initializes a new Activities value that calls the given SimpleServer,
+// converting gRPC status errors into temporal application errors
+func NewActivitiesFromServer(srv SimpleServer) Activities {
+	return &serverActivities{server: srv}
+}
+
+// SomeActivity1 calls the SimpleServer's SomeActivity1 method
+func (a *serverActivities) SomeActivity1(ctx context.Context) error {
+	_, err := a.server.SomeActivity1(ctx, &emptypb.Empty{})
+	return grpcutil.ToApplicationError(err)
+}
+
+// SomeActivity2 calls the SimpleServer's SomeActivity2 method
+func (a *serverActivities) SomeActivity2(ctx context.Context, req *SomeActivity2Request) error {
+	_, err := a.server.SomeActivity2(ctx, req)
+	return grpcutil.ToApplicationError(err)
+}
+
+// SomeActivity3 calls the SimpleServer's SomeActivity3 method
+func (a *serverActivities) SomeActivity3(ctx context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error) {
+	resp, err := a.server.SomeActivity3(ctx, req)
+	if err != nil {
+		return nil, grpcutil.ToApplicationError(err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,943 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: simple/simple.proto
+package simple
+
+import (
+	"context"
+	mock "github.com/stretchr/testify/mock"
+	client "go.temporal.io/sdk/client"
+)
+
+// Compile-time check that MockClient satisfies Client
+var _ Client = &MockClient{}
+
+// MockClient is a testify mock implementation of Client
+type MockClient struct {
+	mock.Mock
+}
+
+// NewMockClient initializes a new MockClient that asserts its expectations when the test completes
+func NewMockClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClient {
+	m := &MockClient{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// SomeWorkflow1 implements Client.SomeWorkflow1
+func (m *MockClient) SomeWorkflow1(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 *SomeWorkflow1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeWorkflow1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnSomeWorkflow1 registers an expectation for a SomeWorkflow1 call
+func (m *MockClient) OnSomeWorkflow1(ctx any, opts any, req any) *MockClientSomeWorkflow1Call {
+	return &MockClientSomeWorkflow1Call{Call: m.On("SomeWorkflow1", ctx, opts, req)}
+}
+
+// MockClientSomeWorkflow1Call wraps a SomeWorkflow1 expectation with typed return values
+type MockClientSomeWorkflow1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeWorkflow1 call
+func (c *MockClientSomeWorkflow1Call) Return(r0 *SomeWorkflow1Response, err error) *MockClientSomeWorkflow1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// ExecuteSomeWorkflow1 implements Client.ExecuteSomeWorkflow1
+func (m *MockClient) ExecuteSomeWorkflow1(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow1Request) (SomeWorkflow1Run, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 SomeWorkflow1Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow1Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteSomeWorkflow1 registers an expectation for a ExecuteSomeWorkflow1 call
+func (m *MockClient) OnExecuteSomeWorkflow1(ctx any, opts any, req any) *MockClientExecuteSomeWorkflow1Call {
+	return &MockClientExecuteSomeWorkflow1Call{Call: m.On("ExecuteSomeWorkflow1", ctx, opts, req)}
+}
+
+// MockClientExecuteSomeWorkflow1Call wraps a ExecuteSomeWorkflow1 expectation with typed return values
+type MockClientExecuteSomeWorkflow1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteSomeWorkflow1 call
+func (c *MockClientExecuteSomeWorkflow1Call) Return(r0 SomeWorkflow1Run, err error) *MockClientExecuteSomeWorkflow1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetSomeWorkflow1 implements Client.GetSomeWorkflow1
+func (m *MockClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) (SomeWorkflow1Run, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow1Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow1Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetSomeWorkflow1 registers an expectation for a GetSomeWorkflow1 call
+func (m *MockClient) OnGetSomeWorkflow1(ctx any, workflowID any, runID any) *MockClientGetSomeWorkflow1Call {
+	return &MockClientGetSomeWorkflow1Call{Call: m.On("GetSomeWorkflow1", ctx, workflowID, runID)}
+}
+
+// MockClientGetSomeWorkflow1Call wraps a GetSomeWorkflow1 expectation with typed return values
+type MockClientGetSomeWorkflow1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetSomeWorkflow1 call
+func (c *MockClientGetSomeWorkflow1Call) Return(r0 SomeWorkflow1Run, err error) *MockClientGetSomeWorkflow1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SomeWorkflow2 implements Client.SomeWorkflow2
+func (m *MockClient) SomeWorkflow2(ctx context.Context, opts *client.StartWorkflowOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+// OnSomeWorkflow2 registers an expectation for a SomeWorkflow2 call
+func (m *MockClient) OnSomeWorkflow2(ctx any, opts any) *MockClientSomeWorkflow2Call {
+	return &MockClientSomeWorkflow2Call{Call: m.On("SomeWorkflow2", ctx, opts)}
+}
+
+// MockClientSomeWorkflow2Call wraps a SomeWorkflow2 expectation with typed return values
+type MockClientSomeWorkflow2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeWorkflow2 call
+func (c *MockClientSomeWorkflow2Call) Return(err error) *MockClientSomeWorkflow2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// ExecuteSomeWorkflow2 implements Client.ExecuteSomeWorkflow2
+func (m *MockClient) ExecuteSomeWorkflow2(ctx context.Context, opts *client.StartWorkflowOptions) (SomeWorkflow2Run, error) {
+	args := m.Called(ctx, opts)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteSomeWorkflow2 registers an expectation for a ExecuteSomeWorkflow2 call
+func (m *MockClient) OnExecuteSomeWorkflow2(ctx any, opts any) *MockClientExecuteSomeWorkflow2Call {
+	return &MockClientExecuteSomeWorkflow2Call{Call: m.On("ExecuteSomeWorkflow2", ctx, opts)}
+}
+
+// MockClientExecuteSomeWorkflow2Call wraps a ExecuteSomeWorkflow2 expectation with typed return values
+type MockClientExecuteSomeWorkflow2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteSomeWorkflow2 call
+func (c *MockClientExecuteSomeWorkflow2Call) Return(r0 SomeWorkflow2Run, err error) *MockClientExecuteSomeWorkflow2Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetSomeWorkflow2 implements Client.GetSomeWorkflow2
+func (m *MockClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) (SomeWorkflow2Run, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetSomeWorkflow2 registers an expectation for a GetSomeWorkflow2 call
+func (m *MockClient) OnGetSomeWorkflow2(ctx any, workflowID any, runID any) *MockClientGetSomeWorkflow2Call {
+	return &MockClientGetSomeWorkflow2Call{Call: m.On("GetSomeWorkflow2", ctx, workflowID, runID)}
+}
+
+// MockClientGetSomeWorkflow2Call wraps a GetSomeWorkflow2 expectation with typed return values
+type MockClientGetSomeWorkflow2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetSomeWorkflow2 call
+func (c *MockClientGetSomeWorkflow2Call) Return(r0 SomeWorkflow2Run, err error) *MockClientGetSomeWorkflow2Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// StartSomeWorkflow2WithSomeSignal1 implements Client.StartSomeWorkflow2WithSomeSignal1
+func (m *MockClient) StartSomeWorkflow2WithSomeSignal1(ctx context.Context, opts *client.StartWorkflowOptions) (SomeWorkflow2Run, error) {
+	args := m.Called(ctx, opts)
+	var r0 SomeWorkflow2Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow2Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnStartSomeWorkflow2WithSomeSignal1 registers an expectation for a StartSomeWorkflow2WithSomeSignal1 call
+func (m *MockClient) OnStartSomeWorkflow2WithSomeSignal1(ctx any, opts any) *MockClientStartSomeWorkflow2WithSomeSignal1Call {
+	return &MockClientStartSomeWorkflow2WithSomeSignal1Call{Call: m.On("StartSomeWorkflow2WithSomeSignal1", ctx, opts)}
+}
+
+// MockClientStartSomeWorkflow2WithSomeSignal1Call wraps a StartSomeWorkflow2WithSomeSignal1 expectation with typed return values
+type MockClientStartSomeWorkflow2WithSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a StartSomeWorkflow2WithSomeSignal1 call
+func (c *MockClientStartSomeWorkflow2WithSomeSignal1Call) Return(r0 SomeWorkflow2Run, err error) *MockClientStartSomeWorkflow2WithSomeSignal1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SomeWorkflow3 implements Client.SomeWorkflow3
+func (m *MockClient) SomeWorkflow3(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) error {
+	args := m.Called(ctx, opts, req)
+	return args.Error(0)
+}
+
+// OnSomeWorkflow3 registers an expectation for a SomeWorkflow3 call
+func (m *MockClient) OnSomeWorkflow3(ctx any, opts any, req any) *MockClientSomeWorkflow3Call {
+	return &MockClientSomeWorkflow3Call{Call: m.On("SomeWorkflow3", ctx, opts, req)}
+}
+
+// MockClientSomeWorkflow3Call wraps a SomeWorkflow3 expectation with typed return values
+type MockClientSomeWorkflow3Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeWorkflow3 call
+func (c *MockClientSomeWorkflow3Call) Return(err error) *MockClientSomeWorkflow3Call {
+	c.Call.Return(err)
+	return c
+}
+
+// ExecuteSomeWorkflow3 implements Client.ExecuteSomeWorkflow3
+func (m *MockClient) ExecuteSomeWorkflow3(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3Run, error) {
+	args := m.Called(ctx, opts, req)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnExecuteSomeWorkflow3 registers an expectation for a ExecuteSomeWorkflow3 call
+func (m *MockClient) OnExecuteSomeWorkflow3(ctx any, opts any, req any) *MockClientExecuteSomeWorkflow3Call {
+	return &MockClientExecuteSomeWorkflow3Call{Call: m.On("ExecuteSomeWorkflow3", ctx, opts, req)}
+}
+
+// MockClientExecuteSomeWorkflow3Call wraps a ExecuteSomeWorkflow3 expectation with typed return values
+type MockClientExecuteSomeWorkflow3Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ExecuteSomeWorkflow3 call
+func (c *MockClientExecuteSomeWorkflow3Call) Return(r0 SomeWorkflow3Run, err error) *MockClientExecuteSomeWorkflow3Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetSomeWorkflow3 implements Client.GetSomeWorkflow3
+func (m *MockClient) GetSomeWorkflow3(ctx context.Context, workflowID string, runID string) (SomeWorkflow3Run, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetSomeWorkflow3 registers an expectation for a GetSomeWorkflow3 call
+func (m *MockClient) OnGetSomeWorkflow3(ctx any, workflowID any, runID any) *MockClientGetSomeWorkflow3Call {
+	return &MockClientGetSomeWorkflow3Call{Call: m.On("GetSomeWorkflow3", ctx, workflowID, runID)}
+}
+
+// MockClientGetSomeWorkflow3Call wraps a GetSomeWorkflow3 expectation with typed return values
+type MockClientGetSomeWorkflow3Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetSomeWorkflow3 call
+func (c *MockClientGetSomeWorkflow3Call) Return(r0 SomeWorkflow3Run, err error) *MockClientGetSomeWorkflow3Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// StartSomeWorkflow3WithSomeSignal2 implements Client.StartSomeWorkflow3WithSomeSignal2
+func (m *MockClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request, signal *SomeSignal2Request) (SomeWorkflow3Run, error) {
+	args := m.Called(ctx, opts, req, signal)
+	var r0 SomeWorkflow3Run
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3Run)
+	}
+	return r0, args.Error(1)
+}
+
+// OnStartSomeWorkflow3WithSomeSignal2 registers an expectation for a StartSomeWorkflow3WithSomeSignal2 call
+func (m *MockClient) OnStartSomeWorkflow3WithSomeSignal2(ctx any, opts any, req any, signal any) *MockClientStartSomeWorkflow3WithSomeSignal2Call {
+	return &MockClientStartSomeWorkflow3WithSomeSignal2Call{Call: m.On("StartSomeWorkflow3WithSomeSignal2", ctx, opts, req, signal)}
+}
+
+// MockClientStartSomeWorkflow3WithSomeSignal2Call wraps a StartSomeWorkflow3WithSomeSignal2 expectation with typed return values
+type MockClientStartSomeWorkflow3WithSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a StartSomeWorkflow3WithSomeSignal2 call
+func (c *MockClientStartSomeWorkflow3WithSomeSignal2Call) Return(r0 SomeWorkflow3Run, err error) *MockClientStartSomeWorkflow3WithSomeSignal2Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// CreateSomeWorkflow3Schedule implements Client.CreateSomeWorkflow3Schedule
+func (m *MockClient) CreateSomeWorkflow3Schedule(ctx context.Context, schedule *client.ScheduleOptions, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3ScheduleHandle, error) {
+	args := m.Called(ctx, schedule, opts, req)
+	var r0 SomeWorkflow3ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3ScheduleHandle)
+	}
+	return r0, args.Error(1)
+}
+
+// OnCreateSomeWorkflow3Schedule registers an expectation for a CreateSomeWorkflow3Schedule call
+func (m *MockClient) OnCreateSomeWorkflow3Schedule(ctx any, schedule any, opts any, req any) *MockClientCreateSomeWorkflow3ScheduleCall {
+	return &MockClientCreateSomeWorkflow3ScheduleCall{Call: m.On("CreateSomeWorkflow3Schedule", ctx, schedule, opts, req)}
+}
+
+// MockClientCreateSomeWorkflow3ScheduleCall wraps a CreateSomeWorkflow3Schedule expectation with typed return values
+type MockClientCreateSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a CreateSomeWorkflow3Schedule call
+func (c *MockClientCreateSomeWorkflow3ScheduleCall) Return(r0 SomeWorkflow3ScheduleHandle, err error) *MockClientCreateSomeWorkflow3ScheduleCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// GetSomeWorkflow3Schedule implements Client.GetSomeWorkflow3Schedule
+func (m *MockClient) GetSomeWorkflow3Schedule(ctx context.Context, scheduleID string) (SomeWorkflow3ScheduleHandle, error) {
+	args := m.Called(ctx, scheduleID)
+	var r0 SomeWorkflow3ScheduleHandle
+	if v := args.Get(0); v != nil {
+		r0 = v.(SomeWorkflow3ScheduleHandle)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGetSomeWorkflow3Schedule registers an expectation for a GetSomeWorkflow3Schedule call
+func (m *MockClient) OnGetSomeWorkflow3Schedule(ctx any, scheduleID any) *MockClientGetSomeWorkflow3ScheduleCall {
+	return &MockClientGetSomeWorkflow3ScheduleCall{Call: m.On("GetSomeWorkflow3Schedule", ctx, scheduleID)}
+}
+
+// MockClientGetSomeWorkflow3ScheduleCall wraps a GetSomeWorkflow3Schedule expectation with typed return values
+type MockClientGetSomeWorkflow3ScheduleCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a GetSomeWorkflow3Schedule call
+func (c *MockClientGetSomeWorkflow3ScheduleCall) Return(r0 SomeWorkflow3ScheduleHandle, err error) *MockClientGetSomeWorkflow3ScheduleCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// QuerySomeQuery1 implements Client.QuerySomeQuery1
+func (m *MockClient) QuerySomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
+	args := m.Called(ctx, workflowID, runID)
+	var r0 *SomeQuery1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnQuerySomeQuery1 registers an expectation for a QuerySomeQuery1 call
+func (m *MockClient) OnQuerySomeQuery1(ctx any, workflowID any, runID any) *MockClientQuerySomeQuery1Call {
+	return &MockClientQuerySomeQuery1Call{Call: m.On("QuerySomeQuery1", ctx, workflowID, runID)}
+}
+
+// MockClientQuerySomeQuery1Call wraps a QuerySomeQuery1 expectation with typed return values
+type MockClientQuerySomeQuery1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a QuerySomeQuery1 call
+func (c *MockClientQuerySomeQuery1Call) Return(r0 *SomeQuery1Response, err error) *MockClientQuerySomeQuery1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// QuerySomeQuery2 implements Client.QuerySomeQuery2
+func (m *MockClient) QuerySomeQuery2(ctx context.Context, workflowID string, runID string, query *SomeQuery2Request) (*SomeQuery2Response, error) {
+	args := m.Called(ctx, workflowID, runID, query)
+	var r0 *SomeQuery2Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery2Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnQuerySomeQuery2 registers an expectation for a QuerySomeQuery2 call
+func (m *MockClient) OnQuerySomeQuery2(ctx any, workflowID any, runID any, query any) *MockClientQuerySomeQuery2Call {
+	return &MockClientQuerySomeQuery2Call{Call: m.On("QuerySomeQuery2", ctx, workflowID, runID, query)}
+}
+
+// MockClientQuerySomeQuery2Call wraps a QuerySomeQuery2 expectation with typed return values
+type MockClientQuerySomeQuery2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a QuerySomeQuery2 call
+func (c *MockClientQuerySomeQuery2Call) Return(r0 *SomeQuery2Response, err error) *MockClientQuerySomeQuery2Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SignalSomeSignal1 implements Client.SignalSomeSignal1
+func (m *MockClient) SignalSomeSignal1(ctx context.Context, workflowID string, runID string) error {
+	args := m.Called(ctx, workflowID, runID)
+	return args.Error(0)
+}
+
+// OnSignalSomeSignal1 registers an expectation for a SignalSomeSignal1 call
+func (m *MockClient) OnSignalSomeSignal1(ctx any, workflowID any, runID any) *MockClientSignalSomeSignal1Call {
+	return &MockClientSignalSomeSignal1Call{Call: m.On("SignalSomeSignal1", ctx, workflowID, runID)}
+}
+
+// MockClientSignalSomeSignal1Call wraps a SignalSomeSignal1 expectation with typed return values
+type MockClientSignalSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalSomeSignal1 call
+func (c *MockClientSignalSomeSignal1Call) Return(err error) *MockClientSignalSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SignalSomeSignal2 implements Client.SignalSomeSignal2
+func (m *MockClient) SignalSomeSignal2(ctx context.Context, workflowID string, runID string, signal *SomeSignal2Request) error {
+	args := m.Called(ctx, workflowID, runID, signal)
+	return args.Error(0)
+}
+
+// OnSignalSomeSignal2 registers an expectation for a SignalSomeSignal2 call
+func (m *MockClient) OnSignalSomeSignal2(ctx any, workflowID any, runID any, signal any) *MockClientSignalSomeSignal2Call {
+	return &MockClientSignalSomeSignal2Call{Call: m.On("SignalSomeSignal2", ctx, workflowID, runID, signal)}
+}
+
+// MockClientSignalSomeSignal2Call wraps a SignalSomeSignal2 expectation with typed return values
+type MockClientSignalSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SignalSomeSignal2 call
+func (c *MockClientSignalSomeSignal2Call) Return(err error) *MockClientSignalSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// UpdateSomeUpdate1 implements Client.UpdateSomeUpdate1
+func (m *MockClient) UpdateSomeUpdate1(ctx context.Context, workflowID string, runID string, update *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	args := m.Called(ctx, workflowID, runID, update)
+	var r0 *SomeUpdate1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeUpdate1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnUpdateSomeUpdate1 registers an expectation for a UpdateSomeUpdate1 call
+func (m *MockClient) OnUpdateSomeUpdate1(ctx any, workflowID any, runID any, update any) *MockClientUpdateSomeUpdate1Call {
+	return &MockClientUpdateSomeUpdate1Call{Call: m.On("UpdateSomeUpdate1", ctx, workflowID, runID, update)}
+}
+
+// MockClientUpdateSomeUpdate1Call wraps a UpdateSomeUpdate1 expectation with typed return values
+type MockClientUpdateSomeUpdate1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a UpdateSomeUpdate1 call
+func (c *MockClientUpdateSomeUpdate1Call) Return(r0 *SomeUpdate1Response, err error) *MockClientUpdateSomeUpdate1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// Compile-time check that MockSomeWorkflow1Run satisfies SomeWorkflow1Run
+var _ SomeWorkflow1Run = &MockSomeWorkflow1Run{}
+
+// MockSomeWorkflow1Run is a testify mock implementation of SomeWorkflow1Run
+type MockSomeWorkflow1Run struct {
+	mock.Mock
+}
+
+// NewMockSomeWorkflow1Run initializes a new MockSomeWorkflow1Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow1Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow1Run {
+	m := &MockSomeWorkflow1Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow1Run.ID
+func (m *MockSomeWorkflow1Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockSomeWorkflow1Run) OnID() *MockSomeWorkflow1RunIDCall {
+	return &MockSomeWorkflow1RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow1RunIDCall wraps a ID expectation with typed return values
+type MockSomeWorkflow1RunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockSomeWorkflow1RunIDCall) Return(r0 string) *MockSomeWorkflow1RunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements SomeWorkflow1Run.RunID
+func (m *MockSomeWorkflow1Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockSomeWorkflow1Run) OnRunID() *MockSomeWorkflow1RunRunIDCall {
+	return &MockSomeWorkflow1RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow1RunRunIDCall wraps a RunID expectation with typed return values
+type MockSomeWorkflow1RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockSomeWorkflow1RunRunIDCall) Return(r0 string) *MockSomeWorkflow1RunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements SomeWorkflow1Run.Get
+func (m *MockSomeWorkflow1Run) Get(ctx context.Context) (*SomeWorkflow1Response, error) {
+	args := m.Called(ctx)
+	var r0 *SomeWorkflow1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeWorkflow1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockSomeWorkflow1Run) OnGet(ctx any) *MockSomeWorkflow1RunGetCall {
+	return &MockSomeWorkflow1RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow1RunGetCall wraps a Get expectation with typed return values
+type MockSomeWorkflow1RunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockSomeWorkflow1RunGetCall) Return(r0 *SomeWorkflow1Response, err error) *MockSomeWorkflow1RunGetCall {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SomeQuery1 implements SomeWorkflow1Run.SomeQuery1
+func (m *MockSomeWorkflow1Run) SomeQuery1(ctx context.Context) (*SomeQuery1Response, error) {
+	args := m.Called(ctx)
+	var r0 *SomeQuery1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnSomeQuery1 registers an expectation for a SomeQuery1 call
+func (m *MockSomeWorkflow1Run) OnSomeQuery1(ctx any) *MockSomeWorkflow1RunSomeQuery1Call {
+	return &MockSomeWorkflow1RunSomeQuery1Call{Call: m.On("SomeQuery1", ctx)}
+}
+
+// MockSomeWorkflow1RunSomeQuery1Call wraps a SomeQuery1 expectation with typed return values
+type MockSomeWorkflow1RunSomeQuery1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeQuery1 call
+func (c *MockSomeWorkflow1RunSomeQuery1Call) Return(r0 *SomeQuery1Response, err error) *MockSomeWorkflow1RunSomeQuery1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SomeQuery2 implements SomeWorkflow1Run.SomeQuery2
+func (m *MockSomeWorkflow1Run) SomeQuery2(ctx context.Context, req *SomeQuery2Request) (*SomeQuery2Response, error) {
+	args := m.Called(ctx, req)
+	var r0 *SomeQuery2Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeQuery2Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnSomeQuery2 registers an expectation for a SomeQuery2 call
+func (m *MockSomeWorkflow1Run) OnSomeQuery2(ctx any, req any) *MockSomeWorkflow1RunSomeQuery2Call {
+	return &MockSomeWorkflow1RunSomeQuery2Call{Call: m.On("SomeQuery2", ctx, req)}
+}
+
+// MockSomeWorkflow1RunSomeQuery2Call wraps a SomeQuery2 expectation with typed return values
+type MockSomeWorkflow1RunSomeQuery2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeQuery2 call
+func (c *MockSomeWorkflow1RunSomeQuery2Call) Return(r0 *SomeQuery2Response, err error) *MockSomeWorkflow1RunSomeQuery2Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// SomeSignal1 implements SomeWorkflow1Run.SomeSignal1
+func (m *MockSomeWorkflow1Run) SomeSignal1(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// OnSomeSignal1 registers an expectation for a SomeSignal1 call
+func (m *MockSomeWorkflow1Run) OnSomeSignal1(ctx any) *MockSomeWorkflow1RunSomeSignal1Call {
+	return &MockSomeWorkflow1RunSomeSignal1Call{Call: m.On("SomeSignal1", ctx)}
+}
+
+// MockSomeWorkflow1RunSomeSignal1Call wraps a SomeSignal1 expectation with typed return values
+type MockSomeWorkflow1RunSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeSignal1 call
+func (c *MockSomeWorkflow1RunSomeSignal1Call) Return(err error) *MockSomeWorkflow1RunSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal2 implements SomeWorkflow1Run.SomeSignal2
+func (m *MockSomeWorkflow1Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnSomeSignal2 registers an expectation for a SomeSignal2 call
+func (m *MockSomeWorkflow1Run) OnSomeSignal2(ctx any, req any) *MockSomeWorkflow1RunSomeSignal2Call {
+	return &MockSomeWorkflow1RunSomeSignal2Call{Call: m.On("SomeSignal2", ctx, req)}
+}
+
+// MockSomeWorkflow1RunSomeSignal2Call wraps a SomeSignal2 expectation with typed return values
+type MockSomeWorkflow1RunSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeSignal2 call
+func (c *MockSomeWorkflow1RunSomeSignal2Call) Return(err error) *MockSomeWorkflow1RunSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeUpdate1 implements SomeWorkflow1Run.SomeUpdate1
+func (m *MockSomeWorkflow1Run) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	args := m.Called(ctx, req)
+	var r0 *SomeUpdate1Response
+	if v := args.Get(0); v != nil {
+		r0 = v.(*SomeUpdate1Response)
+	}
+	return r0, args.Error(1)
+}
+
+// OnSomeUpdate1 registers an expectation for a SomeUpdate1 call
+func (m *MockSomeWorkflow1Run) OnSomeUpdate1(ctx any, req any) *MockSomeWorkflow1RunSomeUpdate1Call {
+	return &MockSomeWorkflow1RunSomeUpdate1Call{Call: m.On("SomeUpdate1", ctx, req)}
+}
+
+// MockSomeWorkflow1RunSomeUpdate1Call wraps a SomeUpdate1 expectation with typed return values
+type MockSomeWorkflow1RunSomeUpdate1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeUpdate1 call
+func (c *MockSomeWorkflow1RunSomeUpdate1Call) Return(r0 *SomeUpdate1Response, err error) *MockSomeWorkflow1RunSomeUpdate1Call {
+	c.Call.Return(r0, err)
+	return c
+}
+
+// Compile-time check that MockSomeWorkflow2Run satisfies SomeWorkflow2Run
+var _ SomeWorkflow2Run = &MockSomeWorkflow2Run{}
+
+// MockSomeWorkflow2Run is a testify mock implementation of SomeWorkflow2Run
+type MockSomeWorkflow2Run struct {
+	mock.Mock
+}
+
+// NewMockSomeWorkflow2Run initializes a new MockSomeWorkflow2Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow2Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow2Run {
+	m := &MockSomeWorkflow2Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow2Run.ID
+func (m *MockSomeWorkflow2Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockSomeWorkflow2Run) OnID() *MockSomeWorkflow2RunIDCall {
+	return &MockSomeWorkflow2RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow2RunIDCall wraps a ID expectation with typed return values
+type MockSomeWorkflow2RunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockSomeWorkflow2RunIDCall) Return(r0 string) *MockSomeWorkflow2RunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements SomeWorkflow2Run.RunID
+func (m *MockSomeWorkflow2Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockSomeWorkflow2Run) OnRunID() *MockSomeWorkflow2RunRunIDCall {
+	return &MockSomeWorkflow2RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow2RunRunIDCall wraps a RunID expectation with typed return values
+type MockSomeWorkflow2RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockSomeWorkflow2RunRunIDCall) Return(r0 string) *MockSomeWorkflow2RunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements SomeWorkflow2Run.Get
+func (m *MockSomeWorkflow2Run) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockSomeWorkflow2Run) OnGet(ctx any) *MockSomeWorkflow2RunGetCall {
+	return &MockSomeWorkflow2RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow2RunGetCall wraps a Get expectation with typed return values
+type MockSomeWorkflow2RunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockSomeWorkflow2RunGetCall) Return(err error) *MockSomeWorkflow2RunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal1 implements SomeWorkflow2Run.SomeSignal1
+func (m *MockSomeWorkflow2Run) SomeSignal1(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// OnSomeSignal1 registers an expectation for a SomeSignal1 call
+func (m *MockSomeWorkflow2Run) OnSomeSignal1(ctx any) *MockSomeWorkflow2RunSomeSignal1Call {
+	return &MockSomeWorkflow2RunSomeSignal1Call{Call: m.On("SomeSignal1", ctx)}
+}
+
+// MockSomeWorkflow2RunSomeSignal1Call wraps a SomeSignal1 expectation with typed return values
+type MockSomeWorkflow2RunSomeSignal1Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeSignal1 call
+func (c *MockSomeWorkflow2RunSomeSignal1Call) Return(err error) *MockSomeWorkflow2RunSomeSignal1Call {
+	c.Call.Return(err)
+	return c
+}
+
+// Compile-time check that MockSomeWorkflow3Run satisfies SomeWorkflow3Run
+var _ SomeWorkflow3Run = &MockSomeWorkflow3Run{}
+
+// MockSomeWorkflow3Run is a testify mock implementation of SomeWorkflow3Run
+type MockSomeWorkflow3Run struct {
+	mock.Mock
+}
+
+// NewMockSomeWorkflow3Run initializes a new MockSomeWorkflow3Run that asserts its expectations when the test completes
+func NewMockSomeWorkflow3Run(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSomeWorkflow3Run {
+	m := &MockSomeWorkflow3Run{}
+	m.Mock.Test(t)
+	t.Cleanup(func() {
+		m.AssertExpectations(t)
+	})
+	return m
+}
+
+// ID implements SomeWorkflow3Run.ID
+func (m *MockSomeWorkflow3Run) ID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnID registers an expectation for a ID call
+func (m *MockSomeWorkflow3Run) OnID() *MockSomeWorkflow3RunIDCall {
+	return &MockSomeWorkflow3RunIDCall{Call: m.On("ID")}
+}
+
+// MockSomeWorkflow3RunIDCall wraps a ID expectation with typed return values
+type MockSomeWorkflow3RunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a ID call
+func (c *MockSomeWorkflow3RunIDCall) Return(r0 string) *MockSomeWorkflow3RunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// RunID implements SomeWorkflow3Run.RunID
+func (m *MockSomeWorkflow3Run) RunID() string {
+	args := m.Called()
+	var r0 string
+	if v := args.Get(0); v != nil {
+		r0 = v.(string)
+	}
+	return r0
+}
+
+// OnRunID registers an expectation for a RunID call
+func (m *MockSomeWorkflow3Run) OnRunID() *MockSomeWorkflow3RunRunIDCall {
+	return &MockSomeWorkflow3RunRunIDCall{Call: m.On("RunID")}
+}
+
+// MockSomeWorkflow3RunRunIDCall wraps a RunID expectation with typed return values
+type MockSomeWorkflow3RunRunIDCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a RunID call
+func (c *MockSomeWorkflow3RunRunIDCall) Return(r0 string) *MockSomeWorkflow3RunRunIDCall {
+	c.Call.Return(r0)
+	return c
+}
+
+// Get implements SomeWorkflow3Run.Get
+func (m *MockSomeWorkflow3Run) Get(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// OnGet registers an expectation for a Get call
+func (m *MockSomeWorkflow3Run) OnGet(ctx any) *MockSomeWorkflow3RunGetCall {
+	return &MockSomeWorkflow3RunGetCall{Call: m.On("Get", ctx)}
+}
+
+// MockSomeWorkflow3RunGetCall wraps a Get expectation with typed return values
+type MockSomeWorkflow3RunGetCall struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a Get call
+func (c *MockSomeWorkflow3RunGetCall) Return(err error) *MockSomeWorkflow3RunGetCall {
+	c.Call.Return(err)
+	return c
+}
+
+// SomeSignal2 implements SomeWorkflow3Run.SomeSignal2
+func (m *MockSomeWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	args := m.Called(ctx, req)
+	return args.Error(0)
+}
+
+// OnSomeSignal2 registers an expectation for a SomeSignal2 call
+func (m *MockSomeWorkflow3Run) OnSomeSignal2(ctx any, req any) *MockSomeWorkflow3RunSomeSignal2Call {
+	return &MockSomeWorkflow3RunSomeSignal2Call{Call: m.On("SomeSignal2", ctx, req)}
+}
+
+// MockSomeWorkflow3RunSomeSignal2Call wraps a SomeSignal2 expectation with typed return values
+type MockSomeWorkflow3RunSomeSignal2Call struct {
+	*mock.Call
+}
+
+// Return sets the values returned by a SomeSignal2 call
+func (c *MockSomeWorkflow3RunSomeSignal2Call) Return(err error) *MockSomeWorkflow3RunSomeSignal2Call {
+	c.Call.Return(err)
+	return c
+}
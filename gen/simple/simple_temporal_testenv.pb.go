@@ -0,0 +1,681 @@
+// Code generated by protoc-gen-go_temporal. DO NOT EDIT.
+// versions:
+//
+//	protoc-gen-go_temporal 0.7.6-next (aff23bc1dabb6ac2b3a06abfc734d7a9d33ff13c)
+//	go go1.20.4
+//	protoc (unknown)
+//
+// source: simple/simple.proto
+package simple
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	expression "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	uuid "github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+	v1 "go.temporal.io/api/enums/v1"
+	serviceerror "go.temporal.io/api/serviceerror"
+	client "go.temporal.io/sdk/client"
+	temporal "go.temporal.io/sdk/temporal"
+	testsuite "go.temporal.io/sdk/testsuite"
+	"sync"
+	"time"
+)
+
+// TestEnv wraps a testsuite.TestWorkflowEnvironment with typed helpers for Simple workflows
+type TestEnv struct {
+	*testsuite.TestWorkflowEnvironment
+}
+
+// NewTestEnv initializes a new TestEnv that registers the given workflows with the test environment.
+// When activities is nil, placeholder activities are registered that must be mocked using the
+// On<Activity> helpers
+func NewTestEnv(env *testsuite.TestWorkflowEnvironment, workflows Workflows, activities Activities) *TestEnv {
+	RegisterWorkflows(env, workflows)
+	if activities == nil {
+		activities = &testEnvActivities{}
+	}
+	RegisterActivities(env, activities)
+	return &TestEnv{env}
+}
+
+// ExecuteSomeWorkflow1 executes a SomeWorkflow1 workflow in the test environment and blocks until it completes
+func (e *TestEnv) ExecuteSomeWorkflow1(req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	e.ExecuteWorkflow(SomeWorkflow1WorkflowName, req)
+	if err := e.GetWorkflowError(); err != nil {
+		return nil, err
+	}
+	var resp SomeWorkflow1Response
+	if err := e.GetWorkflowResult(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ExecuteSomeWorkflow2 executes a SomeWorkflow2 workflow in the test environment and blocks until it completes
+func (e *TestEnv) ExecuteSomeWorkflow2() error {
+	e.ExecuteWorkflow(SomeWorkflow2WorkflowName)
+	return e.GetWorkflowError()
+}
+
+// ExecuteSomeWorkflow3 executes a SomeWorkflow3 workflow in the test environment and blocks until it completes
+func (e *TestEnv) ExecuteSomeWorkflow3(req *SomeWorkflow3Request) error {
+	e.ExecuteWorkflow(SomeWorkflow3WorkflowName, req)
+	return e.GetWorkflowError()
+}
+
+// QuerySomeQuery1 sends a SomeQuery1 query to the workflow executing in the test environment
+func (e *TestEnv) QuerySomeQuery1() (*SomeQuery1Response, error) {
+	val, err := e.QueryWorkflow(SomeQuery1QueryName)
+	if err != nil {
+		return nil, err
+	}
+	var resp SomeQuery1Response
+	if err := val.Get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QuerySomeQuery2 sends a SomeQuery2 query to the workflow executing in the test environment
+func (e *TestEnv) QuerySomeQuery2(req *SomeQuery2Request) (*SomeQuery2Response, error) {
+	val, err := e.QueryWorkflow(SomeQuery2QueryName, req)
+	if err != nil {
+		return nil, err
+	}
+	var resp SomeQuery2Response
+	if err := val.Get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SignalSomeSignal1After sends a SomeSignal1 signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalSomeSignal1After(d time.Duration) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(SomeSignal1SignalName, nil)
+	}, d)
+}
+
+// SignalSomeSignal2After sends a SomeSignal2 signal to the workflow executing in the test environment
+// after the given delay
+func (e *TestEnv) SignalSomeSignal2After(d time.Duration, req *SomeSignal2Request) {
+	e.RegisterDelayedCallback(func() {
+		e.SignalWorkflow(SomeSignal2SignalName, req)
+	}, d)
+}
+
+// OnSomeActivity1 mocks a SomeActivity1 activity execution in the test environment
+func (e *TestEnv) OnSomeActivity1() *TestEnvSomeActivity1Call {
+	return &TestEnvSomeActivity1Call{e.OnActivity(SomeActivity1ActivityName, mock.Anything)}
+}
+
+// TestEnvSomeActivity1Call wraps a SomeActivity1 activity mock with typed return values
+type TestEnvSomeActivity1Call struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked SomeActivity1 activity
+func (c *TestEnvSomeActivity1Call) Return(err error) *TestEnvSomeActivity1Call {
+	c.MockCallWrapper.Return(err)
+	return c
+}
+
+// OnSomeActivity2 mocks a SomeActivity2 activity execution in the test environment
+func (e *TestEnv) OnSomeActivity2(req any) *TestEnvSomeActivity2Call {
+	return &TestEnvSomeActivity2Call{e.OnActivity(SomeActivity2ActivityName, mock.Anything, req)}
+}
+
+// TestEnvSomeActivity2Call wraps a SomeActivity2 activity mock with typed return values
+type TestEnvSomeActivity2Call struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked SomeActivity2 activity
+func (c *TestEnvSomeActivity2Call) Return(err error) *TestEnvSomeActivity2Call {
+	c.MockCallWrapper.Return(err)
+	return c
+}
+
+// OnSomeActivity3 mocks a SomeActivity3 activity execution in the test environment
+func (e *TestEnv) OnSomeActivity3(req any) *TestEnvSomeActivity3Call {
+	return &TestEnvSomeActivity3Call{e.OnActivity(SomeActivity3ActivityName, mock.Anything, req)}
+}
+
+// TestEnvSomeActivity3Call wraps a SomeActivity3 activity mock with typed return values
+type TestEnvSomeActivity3Call struct {
+	*testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mocked SomeActivity3 activity
+func (c *TestEnvSomeActivity3Call) Return(resp *SomeActivity3Response, err error) *TestEnvSomeActivity3Call {
+	c.MockCallWrapper.Return(resp, err)
+	return c
+}
+
+// testEnvActivities provides placeholder activities that must be mocked
+type testEnvActivities struct{}
+
+// SomeActivity1 returns an error indicating that the activity was not mocked
+func (a *testEnvActivities) SomeActivity1(ctx context.Context) error {
+	return fmt.Errorf("%s activity is not mocked", SomeActivity1ActivityName)
+}
+
+// SomeActivity2 returns an error indicating that the activity was not mocked
+func (a *testEnvActivities) SomeActivity2(ctx context.Context, req *SomeActivity2Request) error {
+	return fmt.Errorf("%s activity is not mocked", SomeActivity2ActivityName)
+}
+
+// SomeActivity3 returns an error indicating that the activity was not mocked
+func (a *testEnvActivities) SomeActivity3(ctx context.Context, req *SomeActivity3Request) (*SomeActivity3Response, error) {
+	return nil, fmt.Errorf("%s activity is not mocked", SomeActivity3ActivityName)
+}
+
+// Compile-time check that TestClient satisfies Client
+var _ Client = &TestClient{}
+
+// TestClient is an in-memory Client implementation that executes workflows using a TestEnv.
+// Signals sent before a workflow is executed are delivered when it starts, while Get, queries,
+// and updates execute the workflow to completion on first use
+type TestClient struct {
+	newEnv func() *TestEnv
+	mu     sync.Mutex
+	runs   map[string]*testClientRun
+}
+
+// NewTestClient initializes a new TestClient that calls newEnv to create the TestEnv used by each
+// workflow execution
+func NewTestClient(newEnv func() *TestEnv) *TestClient {
+	return &TestClient{
+		newEnv: newEnv,
+		runs:   map[string]*testClientRun{},
+	}
+}
+
+// start initializes a new workflow execution using a new test environment
+func (c *TestClient) start(opts *client.StartWorkflowOptions, workflow string, args ...any) (*testClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if opts.ID == "" {
+		opts.ID = uuid.NewString()
+	}
+	if run, ok := c.runs[opts.ID]; ok {
+		run.mu.Lock()
+		executed := run.executed
+		run.mu.Unlock()
+		if !executed {
+			return nil, serviceerror.NewWorkflowExecutionAlreadyStarted(fmt.Sprintf("workflow %s already started", opts.ID), "", run.runID)
+		}
+	}
+	env := c.newEnv()
+	env.SetStartWorkflowOptions(*opts)
+	run := &testClientRun{
+		args:     args,
+		env:      env,
+		id:       opts.ID,
+		runID:    uuid.NewString(),
+		workflow: workflow,
+	}
+	c.runs[opts.ID] = run
+	return run, nil
+}
+
+// run returns an existing workflow execution
+func (c *TestClient) run(workflowID string, runID string) (*testClientRun, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	run, ok := c.runs[workflowID]
+	if !ok || (runID != "" && runID != run.runID) {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow %s not found", workflowID))
+	}
+	return run, nil
+}
+
+// SomeWorkflow1 executes a SomeWorkflow1 workflow in a new test environment and blocks until it completes
+func (c *TestClient) SomeWorkflow1(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow1Request) (*SomeWorkflow1Response, error) {
+	run, err := c.ExecuteSomeWorkflow1(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteSomeWorkflow1 starts a SomeWorkflow1 workflow in a new test environment
+func (c *TestClient) ExecuteSomeWorkflow1(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow1Request) (SomeWorkflow1Run, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SomeWorkflow1IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue"
+	}
+	run, err := c.start(opts, SomeWorkflow1WorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow1Run{run}, nil
+}
+
+// GetSomeWorkflow1 fetches an existing SomeWorkflow1 execution started by the test client
+func (c *TestClient) GetSomeWorkflow1(ctx context.Context, workflowID string, runID string) (SomeWorkflow1Run, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow1Run{run}, nil
+}
+
+// SomeWorkflow2 executes a SomeWorkflow2 workflow in a new test environment and blocks until it completes
+func (c *TestClient) SomeWorkflow2(ctx context.Context, opts *client.StartWorkflowOptions) error {
+	run, err := c.ExecuteSomeWorkflow2(ctx, opts)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteSomeWorkflow2 starts a SomeWorkflow2 workflow in a new test environment
+func (c *TestClient) ExecuteSomeWorkflow2(ctx context.Context, opts *client.StartWorkflowOptions) (SomeWorkflow2Run, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue"
+	}
+	run, err := c.start(opts, SomeWorkflow2WorkflowName)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow2Run{run}, nil
+}
+
+// GetSomeWorkflow2 fetches an existing SomeWorkflow2 execution started by the test client
+func (c *TestClient) GetSomeWorkflow2(ctx context.Context, workflowID string, runID string) (SomeWorkflow2Run, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow2Run{run}, nil
+}
+
+// StartSomeWorkflow2WithSomeSignal1 starts a SomeWorkflow2 workflow and sends a SomeSignal1 signal that is delivered when it starts
+func (c *TestClient) StartSomeWorkflow2WithSomeSignal1(ctx context.Context, opts *client.StartWorkflowOptions) (SomeWorkflow2Run, error) {
+	run, err := c.ExecuteSomeWorkflow2(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	if err := run.SomeSignal1(ctx); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// SomeWorkflow3 executes a SomeWorkflow3 workflow in a new test environment and blocks until it completes
+func (c *TestClient) SomeWorkflow3(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) error {
+	run, err := c.ExecuteSomeWorkflow3(ctx, opts, req)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx)
+}
+
+// ExecuteSomeWorkflow3 starts a SomeWorkflow3 workflow in a new test environment
+func (c *TestClient) ExecuteSomeWorkflow3(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3Run, error) {
+	if opts == nil {
+		opts = &client.StartWorkflowOptions{}
+	}
+	if opts.ID == "" {
+		id, err := expression.EvalExpression(SomeWorkflow3IDExpression, req.ProtoReflect())
+		if err != nil {
+			return nil, err
+		}
+		opts.ID = id
+	}
+	if opts.TaskQueue == "" {
+		opts.TaskQueue = "my-task-queue-2"
+	}
+	if opts.WorkflowIDReusePolicy == v1.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.WorkflowIDReusePolicy = v1.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE
+	}
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = &temporal.RetryPolicy{MaximumAttempts: int32(2)}
+	}
+	if opts.WorkflowExecutionTimeout == 0 {
+		opts.WorkflowExecutionTimeout = 3600000000000 // 1h0m0s
+	}
+	if opts.WorkflowRunTimeout == 0 {
+		opts.WorkflowRunTimeout = 1800000000000 // 30m0s
+	}
+	if opts.WorkflowTaskTimeout == 0 {
+		opts.WorkflowTaskTimeout = 30000000000 // 30s
+	}
+	run, err := c.start(opts, SomeWorkflow3WorkflowName, req)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow3Run{run}, nil
+}
+
+// GetSomeWorkflow3 fetches an existing SomeWorkflow3 execution started by the test client
+func (c *TestClient) GetSomeWorkflow3(ctx context.Context, workflowID string, runID string) (SomeWorkflow3Run, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return &testSomeWorkflow3Run{run}, nil
+}
+
+// StartSomeWorkflow3WithSomeSignal2 starts a SomeWorkflow3 workflow and sends a SomeSignal2 signal that is delivered when it starts
+func (c *TestClient) StartSomeWorkflow3WithSomeSignal2(ctx context.Context, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request, signal *SomeSignal2Request) (SomeWorkflow3Run, error) {
+	run, err := c.ExecuteSomeWorkflow3(ctx, opts, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := run.SomeSignal2(ctx, signal); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// CreateSomeWorkflow3Schedule returns an error, as schedules are not supported by the test client
+func (c *TestClient) CreateSomeWorkflow3Schedule(ctx context.Context, schedule *client.ScheduleOptions, opts *client.StartWorkflowOptions, req *SomeWorkflow3Request) (SomeWorkflow3ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by TestClient")
+}
+
+// GetSomeWorkflow3Schedule returns an error, as schedules are not supported by the test client
+func (c *TestClient) GetSomeWorkflow3Schedule(ctx context.Context, scheduleID string) (SomeWorkflow3ScheduleHandle, error) {
+	return nil, errors.New("schedules are not supported by TestClient")
+}
+
+// QuerySomeQuery1 executes an existing workflow to completion and sends it a SomeQuery1 query
+func (c *TestClient) QuerySomeQuery1(ctx context.Context, workflowID string, runID string) (*SomeQuery1Response, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	var resp SomeQuery1Response
+	if err := run.query(SomeQuery1QueryName, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// QuerySomeQuery2 executes an existing workflow to completion and sends it a SomeQuery2 query
+func (c *TestClient) QuerySomeQuery2(ctx context.Context, workflowID string, runID string, query *SomeQuery2Request) (*SomeQuery2Response, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	var resp SomeQuery2Response
+	if err := run.query(SomeQuery2QueryName, &resp, query); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SignalSomeSignal1 sends a SomeSignal1 signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalSomeSignal1(ctx context.Context, workflowID string, runID string) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(SomeSignal1SignalName, nil)
+}
+
+// SignalSomeSignal2 sends a SomeSignal2 signal to an existing workflow that has not yet been executed
+func (c *TestClient) SignalSomeSignal2(ctx context.Context, workflowID string, runID string, signal *SomeSignal2Request) error {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return err
+	}
+	return run.signal(SomeSignal2SignalName, signal)
+}
+
+// UpdateSomeUpdate1 sends a SomeUpdate1 update to an existing workflow and executes it to completion
+func (c *TestClient) UpdateSomeUpdate1(ctx context.Context, workflowID string, runID string, update *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	run, err := c.run(workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	out, err := run.update(SomeUpdate1UpdateName, update)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := out.(*SomeUpdate1Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected SomeUpdate1 update result type: %T", out)
+	}
+	return resp, nil
+}
+
+// testClientRun describes a workflow execution started by a TestClient
+type testClientRun struct {
+	mu       sync.Mutex
+	env      *TestEnv
+	id       string
+	runID    string
+	workflow string
+	args     []any
+	executed bool
+}
+
+// execute runs the workflow to completion if it has not already been executed
+func (r *testClientRun) execute() {
+	if !r.executed {
+		r.executed = true
+		r.env.ExecuteWorkflow(r.workflow, r.args...)
+	}
+}
+
+// get executes the workflow and decodes its result into resp, if not nil
+func (r *testClientRun) get(resp any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.execute()
+	if err := r.env.GetWorkflowError(); err != nil || resp == nil {
+		return err
+	}
+	return r.env.GetWorkflowResult(resp)
+}
+
+// query executes the workflow and decodes the result of the named query into resp
+func (r *testClientRun) query(name string, resp any, args ...any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.execute()
+	val, err := r.env.QueryWorkflow(name, args...)
+	if err != nil {
+		return err
+	}
+	return val.Get(resp)
+}
+
+// signal queues the named signal for delivery when the workflow starts
+func (r *testClientRun) signal(name string, arg any) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.executed {
+		return serviceerror.NewNotFound(fmt.Sprintf("workflow %s already completed", r.id))
+	}
+	r.env.RegisterDelayedCallback(func() {
+		r.env.SignalWorkflow(name, arg)
+	}, 0)
+	return nil
+}
+
+// update queues the named update for delivery once the workflow starts, executes the workflow,
+// and returns the update result
+func (r *testClientRun) update(name string, args ...any) (any, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.executed {
+		return nil, serviceerror.NewNotFound(fmt.Sprintf("workflow %s already completed", r.id))
+	}
+	uc := &testUpdateCallbacks{}
+	// callbacks registered before execution run before the workflow starts, so queue the
+	// update behind the start of the workflow
+	r.env.RegisterDelayedCallback(func() {
+		r.env.RegisterDelayedCallback(func() {
+			r.env.UpdateWorkflow(name, uc, args...)
+		}, 0)
+	}, 0)
+	r.execute()
+	if !uc.completed {
+		return nil, fmt.Errorf("%s update did not complete", name)
+	}
+	return uc.result, uc.err
+}
+
+// testUpdateCallbacks records the outcome of an update sent by a TestClient
+type testUpdateCallbacks struct {
+	completed bool
+	result    any
+	err       error
+}
+
+// Accept is called when an update is accepted by the workflow
+func (uc *testUpdateCallbacks) Accept() {}
+
+// Reject is called when an update is rejected by the workflow
+func (uc *testUpdateCallbacks) Reject(err error) {
+	uc.completed = true
+	uc.err = err
+}
+
+// Complete is called when an update handler returns
+func (uc *testUpdateCallbacks) Complete(success any, err error) {
+	uc.completed = true
+	uc.result = success
+	uc.err = err
+}
+
+// testSomeWorkflow1Run provides a SomeWorkflow1Run implementation for executions started by a TestClient
+type testSomeWorkflow1Run struct {
+	run *testClientRun
+}
+
+// ID returns the workflow ID
+func (r *testSomeWorkflow1Run) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testSomeWorkflow1Run) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testSomeWorkflow1Run) Get(ctx context.Context) (*SomeWorkflow1Response, error) {
+	var resp SomeWorkflow1Response
+	if err := r.run.get(&resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SomeQuery1 executes the workflow to completion and sends it a SomeQuery1 query
+func (r *testSomeWorkflow1Run) SomeQuery1(ctx context.Context) (*SomeQuery1Response, error) {
+	run := r.run
+	var resp SomeQuery1Response
+	if err := run.query(SomeQuery1QueryName, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SomeQuery2 executes the workflow to completion and sends it a SomeQuery2 query
+func (r *testSomeWorkflow1Run) SomeQuery2(ctx context.Context, req *SomeQuery2Request) (*SomeQuery2Response, error) {
+	run := r.run
+	var resp SomeQuery2Response
+	if err := run.query(SomeQuery2QueryName, &resp, req); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// SomeSignal1 sends a SomeSignal1 signal that is delivered when the workflow starts
+func (r *testSomeWorkflow1Run) SomeSignal1(ctx context.Context) error {
+	run := r.run
+	return run.signal(SomeSignal1SignalName, nil)
+}
+
+// SomeSignal2 sends a SomeSignal2 signal that is delivered when the workflow starts
+func (r *testSomeWorkflow1Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	run := r.run
+	return run.signal(SomeSignal2SignalName, req)
+}
+
+// SomeUpdate1 sends a SomeUpdate1 update and executes the workflow to completion
+func (r *testSomeWorkflow1Run) SomeUpdate1(ctx context.Context, req *SomeUpdate1Request) (*SomeUpdate1Response, error) {
+	run := r.run
+	out, err := run.update(SomeUpdate1UpdateName, req)
+	if err != nil {
+		return nil, err
+	}
+	resp, ok := out.(*SomeUpdate1Response)
+	if !ok {
+		return nil, fmt.Errorf("unexpected SomeUpdate1 update result type: %T", out)
+	}
+	return resp, nil
+}
+
+// testSomeWorkflow2Run provides a SomeWorkflow2Run implementation for executions started by a TestClient
+type testSomeWorkflow2Run struct {
+	run *testClientRun
+}
+
+// ID returns the workflow ID
+func (r *testSomeWorkflow2Run) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testSomeWorkflow2Run) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testSomeWorkflow2Run) Get(ctx context.Context) error {
+	return r.run.get(nil)
+}
+
+// SomeSignal1 sends a SomeSignal1 signal that is delivered when the workflow starts
+func (r *testSomeWorkflow2Run) SomeSignal1(ctx context.Context) error {
+	run := r.run
+	return run.signal(SomeSignal1SignalName, nil)
+}
+
+// testSomeWorkflow3Run provides a SomeWorkflow3Run implementation for executions started by a TestClient
+type testSomeWorkflow3Run struct {
+	run *testClientRun
+}
+
+// ID returns the workflow ID
+func (r *testSomeWorkflow3Run) ID() string {
+	return r.run.id
+}
+
+// RunID returns the workflow run ID
+func (r *testSomeWorkflow3Run) RunID() string {
+	return r.run.runID
+}
+
+// Get executes the workflow to completion and returns its result
+func (r *testSomeWorkflow3Run) Get(ctx context.Context) error {
+	return r.run.get(nil)
+}
+
+// SomeSignal2 sends a SomeSignal2 signal that is delivered when the workflow starts
+func (r *testSomeWorkflow3Run) SomeSignal2(ctx context.Context, req *SomeSignal2Request) error {
+	run := r.run
+	return run.signal(SomeSignal2SignalName, req)
+}
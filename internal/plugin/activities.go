@@ -2,7 +2,6 @@ package plugin
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	g "github.com/dave/jennifer/jen"
@@ -10,8 +9,8 @@ import (
 
 // genActivitiesInterface generates an Activities interface
 func (svc *Service) genActivitiesInterface(f *g.File) {
-	f.Comment("Activities describes available worker activites")
-	f.Type().Id("Activities").InterfaceFunc(func(methods *g.Group) {
+	f.Commentf("%s describes available worker activites", svc.names.activities)
+	f.Type().Id(svc.names.activities).InterfaceFunc(func(methods *g.Group) {
 		for _, activity := range svc.activitiesOrdered {
 			method := svc.methods[activity]
 			methods.Comment(strings.TrimSuffix(method.Comments.Leading.String(), "\n"))
@@ -21,12 +20,12 @@ func (svc *Service) genActivitiesInterface(f *g.File) {
 				ParamsFunc(func(args *g.Group) {
 					args.Id("ctx").Qual("context", "Context")
 					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(messageType(method.Input))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(messageType(method.Output))
 					}
 					returnVals.Error()
 				})
@@ -36,11 +35,11 @@ func (svc *Service) genActivitiesInterface(f *g.File) {
 
 // genActivitiesInterface generates a RegisterActivities public function
 func (svc *Service) genRegisterActivities(f *g.File) {
-	f.Comment("RegisterActivities registers activities with a worker")
-	f.Func().Id("RegisterActivities").
+	f.Commentf("%s registers activities with a worker", svc.names.registerActivities)
+	f.Func().Id(svc.names.registerActivities).
 		Params(
 			g.Id("r").Qual(workerPkg, "Registry"),
-			g.Id("activities").Id("Activities"),
+			g.Id("activities").Id(svc.names.activities),
 		).
 		BlockFunc(func(fn *g.Group) {
 			for _, activity := range svc.activitiesOrdered {
@@ -64,12 +63,12 @@ func (svc *Service) genRegisterActivity(f *g.File, activity string) {
 				ParamsFunc(func(args *g.Group) {
 					args.Qual("context", "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(messageType(method.Input))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(messageType(method.Output))
 					}
 					returnVals.Error()
 				}),
@@ -106,13 +105,13 @@ func (svc *Service) genActivityFutureGetMethod(f *g.File, activity string) {
 		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Output))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(messageType(method.Output))
 				fn.If(
 					g.Err().Op(":=").Id("f").Dot("Future").Dot("Get").Call(
 						g.Id("ctx"), g.Op("&").Id("resp"),
@@ -161,6 +160,59 @@ func (svc *Service) genActivityFutureSelectMethod(f *g.File, activity string) {
 		)
 }
 
+// activityDefaults returns the default activity options for the given activity
+func (svc *Service) activityDefaults(activity string, local bool) (defaults []optionDefault) {
+	if local {
+		return svc.localActivityDefaults(activity)
+	}
+	opts := svc.activities[activity].GetDefaultOptions()
+
+	// workflow context options inherit the current workflow's task queue
+	if taskQueue := opts.GetTaskQueue(); taskQueue != "" {
+		defaults = append(defaults, optionDefault{field: "TaskQueue", value: g.Lit(taskQueue), zero: g.Lit(""), inherited: true})
+	}
+
+	defaults = append(defaults, retryPolicyDefault(opts.GetRetryPolicy())...)
+	defaults = append(defaults, durationDefault("HeartbeatTimeout", opts.GetHeartbeatTimeout(), false)...)
+	defaults = append(defaults, durationDefault("ScheduleToCloseTimeout", opts.GetScheduleToCloseTimeout(), false)...)
+	defaults = append(defaults, durationDefault("ScheduleToStartTimeout", opts.GetScheduleToStartTimeout(), false)...)
+	defaults = append(defaults, durationDefault("StartToCloseTimeout", opts.GetStartToCloseTimeout(), false)...)
+
+	// boolean defaults can only be distinguished from explicit values when options are not provided
+	if opts != nil && opts.WaitForCancellation != nil {
+		defaults = append(defaults, optionDefault{field: "WaitForCancellation", value: g.Lit(opts.GetWaitForCancellation())})
+	}
+	if opts != nil && opts.DisableEagerExecution != nil {
+		defaults = append(defaults, optionDefault{field: "DisableEagerExecution", value: g.Lit(opts.GetDisableEagerExecution())})
+	}
+	return defaults
+}
+
+// localActivityDefaults returns the default local activity options for the given activity,
+// preferring local_options and falling back to default_options for unset fields
+func (svc *Service) localActivityDefaults(activity string) (defaults []optionDefault) {
+	opts := svc.activities[activity].GetDefaultOptions()
+	localOpts := svc.activities[activity].GetLocalOptions()
+
+	retryPolicy := localOpts.GetRetryPolicy()
+	if retryPolicy == nil {
+		retryPolicy = opts.GetRetryPolicy()
+	}
+	scheduleToClose := localOpts.GetScheduleToCloseTimeout()
+	if scheduleToClose == nil {
+		scheduleToClose = opts.GetScheduleToCloseTimeout()
+	}
+	startToClose := localOpts.GetStartToCloseTimeout()
+	if startToClose == nil {
+		startToClose = opts.GetStartToCloseTimeout()
+	}
+
+	defaults = append(defaults, retryPolicyDefault(retryPolicy)...)
+	defaults = append(defaults, durationDefault("ScheduleToCloseTimeout", scheduleToClose, false)...)
+	defaults = append(defaults, durationDefault("StartToCloseTimeout", startToClose, false)...)
+	return defaults
+}
+
 // genActivityFunction generates a public <Activity>[Local] function
 func (svc *Service) genActivityFunction(f *g.File, activity string, local bool) {
 	method := svc.methods[activity]
@@ -187,18 +239,18 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local bool)
 					ParamsFunc(func(fnargs *g.Group) {
 						fnargs.Qual("context", "Context")
 						if hasInput {
-							fnargs.Op("*").Id(method.Input.GoIdent.GoName)
+							fnargs.Op("*").Add(messageType(method.Input))
 						}
 					}).
 					ParamsFunc(func(fnreturn *g.Group) {
 						if hasOutput {
-							fnreturn.Op("*").Id(method.Output.GoIdent.GoName)
+							fnreturn.Op("*").Add(messageType(method.Output))
 						}
 						fnreturn.Error()
 					})
 			}
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Params(
@@ -206,6 +258,7 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local bool)
 		).
 		BlockFunc(func(fn *g.Group) {
 			// initialize activity options if nil
+			defaults := svc.activityDefaults(activity, local)
 			fn.If(g.Id("opts").Op("==").Nil()).BlockFunc(func(bl *g.Group) {
 				optionsFn := "GetActivityOptions"
 				if local {
@@ -214,59 +267,23 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local bool)
 				bl.Id("activityOpts").Op(":=").Qual(workflowPkg, optionsFn).Call(
 					g.Id("ctx"),
 				)
+				genInheritedDefaults(bl, "activityOpts", defaults)
 				bl.Id("opts").Op("=").Op("&").Id("activityOpts")
 			})
 
-			// set default retry policy
-			if policy := opts.GetRetryPolicy(); policy != nil {
-				fn.If(g.Id("opts").Dot("RetryPolicy").Op("==").Nil()).Block(
-					g.Id("opts").Dot("RetryPolicy").Op("=").Op("&").Qual(temporalPkg, "RetryPolicy").ValuesFunc(func(fields *g.Group) {
-						if d := policy.GetInitialInterval(); d.IsValid() {
-							fields.Id("InitialInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
-						}
-						if d := policy.GetMaxInterval(); d.IsValid() {
-							fields.Id("MaximumInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
-						}
-						if n := policy.GetBackoffCoefficient(); n != 0 {
-							fields.Id("BackoffCoefficient").Op(":").Lit(n)
-						}
-						if n := policy.GetMaxAttempts(); n != 0 {
-							fields.Id("MaximumAttempts").Op(":").Lit(n)
-						}
-						if errs := policy.GetNonRetryableErrorTypes(); len(errs) > 0 {
-							fields.Id("NonRetryableErrorTypes").Op(":").Lit(errs)
-						}
-					}),
-				)
-			}
-
-			// set default heartbeat timeout
-			if timeout := opts.GetHeartbeatTimeout(); !local && timeout.IsValid() {
-				fn.If(g.Id("opts").Dot("HeartbeatTimeout").Op("==").Lit(0)).Block(
-					g.Id("opts").Dot("HeartbeatTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-				)
-			}
-
-			// set default schedule to close timeout
-			if timeout := opts.GetScheduleToCloseTimeout(); timeout.IsValid() {
-				fn.If(g.Id("opts").Dot("ScheduleToCloseTimeout").Op("==").Lit(0)).Block(
-					g.Id("opts").Dot("ScheduleToCloseTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-				)
-			}
-
-			// set default schedule to start timeout
-			if timeout := opts.GetScheduleToStartTimeout(); !local && timeout.IsValid() {
-				fn.If(g.Id("opts").Dot("ScheduleToStartTimeout").Op("==").Lit(0)).Block(
-					g.Id("opts").Dot("ScheduleToStartTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-				)
+			// set default activity id
+			if !local && opts.GetActivityId() != "" {
+				fn.If(g.Id("opts").Dot("ActivityID").Op("==").Lit("")).BlockFunc(func(bl *g.Group) {
+					svc.genSideEffectID(bl, activity, "ActivityID", fmt.Sprintf("%sActivityIDExpression", activity), func(bl *g.Group, err g.Code) {
+						bl.List(g.Id("future"), g.Id("settable")).Op(":=").Qual(workflowPkg, "NewFuture").Call(g.Id("ctx"))
+						bl.Id("settable").Dot("SetError").Call(err)
+						bl.Return(g.Op("&").Id(fmt.Sprintf("%sFuture", method.GoName)).Values(g.Id("Future").Op(":").Id("future")))
+					})
+				})
 			}
 
-			// set default start to close timeout
-			if timeout := opts.GetStartToCloseTimeout(); timeout.IsValid() {
-				fn.If(g.Id("opts").Dot("StartToCloseTimeout").Op("==").Lit(0)).Block(
-					g.Id("opts").Dot("StartToCloseTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-				)
-			}
+			// set remaining defaults if unset
+			genUnsetDefaults(fn, defaults)
 
 			// inject ctx with activity options
 			if local {
@@ -317,3 +334,161 @@ func (svc *Service) genActivityFunction(f *g.File, activity string, local bool)
 			)
 		})
 }
+
+// genActivitiesFromServer generates an Activities implementation that calls a grpc server
+func (svc *Service) genActivitiesFromServer(f *g.File) {
+	if len(svc.activitiesOrdered) == 0 {
+		return
+	}
+	serverName := fmt.Sprintf("%sServer", svc.GoName)
+
+	f.Commentf("Compile-time check that %s satisfies %s", svc.names.serverActivities, svc.names.activities)
+	f.Var().Op("_").Id(svc.names.activities).Op("=").Op("&").Id(svc.names.serverActivities).Block()
+
+	f.Commentf("%s implements %s by calling a %s", svc.names.serverActivities, svc.names.activities, serverName)
+	f.Type().Id(svc.names.serverActivities).Struct(
+		g.Id("server").Add(svc.grpcIdent(serverName)),
+	)
+
+	f.Commentf("%s initializes a new %s value that calls the given %s,", svc.names.newActivitiesFromServer, svc.names.activities, serverName)
+	f.Comment("converting gRPC status errors into temporal application errors")
+	f.Func().
+		Id(svc.names.newActivitiesFromServer).
+		Params(g.Id("srv").Add(svc.grpcIdent(serverName))).
+		Params(g.Id(svc.names.activities)).
+		Block(
+			g.Return(g.Op("&").Id(svc.names.serverActivities).Values(
+				g.Id("server").Op(":").Id("srv"),
+			)),
+		)
+
+	for _, activity := range svc.activitiesOrdered {
+		method := svc.methods[activity]
+		hasInput := !isEmpty(method.Input)
+		hasOutput := !isEmpty(method.Output)
+
+		f.Commentf("%s calls the %s's %s method", activity, serverName, activity)
+		f.Func().
+			Params(g.Id("a").Op("*").Id(svc.names.serverActivities)).
+			Id(activity).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(method.Input))
+				}
+			}).
+			ParamsFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Op("*").Add(messageType(method.Output))
+				}
+				returnVals.Error()
+			}).
+			BlockFunc(func(fn *g.Group) {
+				call := g.Id("a").Dot("server").Dot(activity).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if hasInput {
+						args.Id("req")
+					} else {
+						args.Op("&").Qual(emptyPkg, "Empty").Values()
+					}
+				})
+				if hasOutput {
+					fn.List(g.Id("resp"), g.Err()).Op(":=").Add(call)
+					fn.If(g.Err().Op("!=").Nil()).Block(
+						g.Return(g.Nil(), g.Qual(grpcutilPkg, "ToApplicationError").Call(g.Err())),
+					)
+					fn.Return(g.Id("resp"), g.Nil())
+				} else {
+					fn.List(g.Id("_"), g.Err()).Op(":=").Add(call)
+					fn.Return(g.Qual(grpcutilPkg, "ToApplicationError").Call(g.Err()))
+				}
+			})
+	}
+}
+
+// genRemoteActivities generates an Activities implementation that executes remote
+// activities by invoking the corresponding unary rpc on a gRPC connection
+func (svc *Service) genRemoteActivities(f *g.File) {
+	var remote []string
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetRemote() {
+			remote = append(remote, activity)
+		}
+	}
+	if len(remote) == 0 {
+		return
+	}
+
+	f.Commentf("Compile-time check that %s satisfies %s", svc.names.remoteActivities, svc.names.activities)
+	f.Var().Op("_").Id(svc.names.activities).Op("=").Op("&").Id(svc.names.remoteActivities).Block()
+
+	f.Commentf("%s implements %s by invoking remote activities on a gRPC connection", svc.names.remoteActivities, svc.names.activities)
+	f.Comment("and delegating all other activities to the embedded implementation")
+	f.Type().Id(svc.names.remoteActivities).Struct(
+		g.Id(svc.names.activities),
+		g.Id("conn").Qual(grpcPkg, "ClientConnInterface"),
+	)
+
+	f.Commentf("%s initializes a new %s value that executes remote activities by", svc.names.newRemoteActivities, svc.names.activities)
+	f.Comment("invoking the corresponding unary rpc on the given connection, delegating all other activities")
+	f.Comment("to local, which may be nil if every activity is remote")
+	f.Func().
+		Id(svc.names.newRemoteActivities).
+		Params(
+			g.Id("conn").Qual(grpcPkg, "ClientConnInterface"),
+			g.Id("local").Id(svc.names.activities),
+		).
+		Params(g.Id(svc.names.activities)).
+		Block(
+			g.Return(g.Op("&").Id(svc.names.remoteActivities).Values(
+				g.Id(svc.names.activities).Op(":").Id("local"),
+				g.Id("conn").Op(":").Id("conn"),
+			)),
+		)
+
+	for _, activity := range remote {
+		method := svc.methods[activity]
+		hasInput := !isEmpty(method.Input)
+		hasOutput := !isEmpty(method.Output)
+		fullMethod := fmt.Sprintf("/%s/%s", svc.Desc.FullName(), method.Desc.Name())
+
+		f.Commentf("%s invokes the remote %s rpc", activity, fullMethod)
+		f.Func().
+			Params(g.Id("a").Op("*").Id(svc.names.remoteActivities)).
+			Id(activity).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(method.Input))
+				}
+			}).
+			ParamsFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Op("*").Add(messageType(method.Output))
+				}
+				returnVals.Error()
+			}).
+			BlockFunc(func(fn *g.Group) {
+				fn.Id("resp").Op(":=").Op("&").Add(messageType(method.Output)).Values()
+				invoke := g.Qual(grpcutilPkg, "InvokeActivity").CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					args.Id("a").Dot("conn")
+					args.Lit(fullMethod)
+					if hasInput {
+						args.Id("req")
+					} else {
+						args.Op("&").Qual(emptyPkg, "Empty").Values()
+					}
+					args.Id("resp")
+				})
+				if hasOutput {
+					fn.If(g.Err().Op(":=").Add(invoke), g.Err().Op("!=").Nil()).Block(
+						g.Return(g.Nil(), g.Err()),
+					)
+					fn.Return(g.Id("resp"), g.Nil())
+				} else {
+					fn.Return(invoke)
+				}
+			})
+	}
+}
@@ -0,0 +1,165 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// contract describes the wire identity of a temporal activity, query, signal, update, or
+// workflow definition
+type contract struct {
+	kind    string
+	method  protoreflect.FullName
+	name    string
+	input   protoreflect.FullName
+	output  protoreflect.FullName
+	id      string
+	queries []string
+	signals []string
+	updates []string
+}
+
+// BreakingChanges compares the temporal definitions in the base and head descriptor sets and
+// describes changes that break running workflows or existing callers, including removed
+// definitions, renamed workflow, activity, query, signal, or update names, changed input or
+// output types, signals, queries, or updates removed from workflows, and changed workflow id
+// expressions. Definitions are matched by kind and fully qualified rpc method name
+func BreakingChanges(base, head *descriptorpb.FileDescriptorSet) ([]string, error) {
+	before, err := contracts(base)
+	if err != nil {
+		return nil, fmt.Errorf("error reading base descriptor set: %w", err)
+	}
+	after, err := contracts(head)
+	if err != nil {
+		return nil, fmt.Errorf("error reading head descriptor set: %w", err)
+	}
+
+	keys := make([]string, 0, len(before))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []string
+	for _, key := range keys {
+		prev, next := before[key], after[key]
+		report := func(format string, args ...any) {
+			changes = append(changes, fmt.Sprintf("%s %s %s", prev.kind, prev.method, fmt.Sprintf(format, args...)))
+		}
+		if next == nil {
+			report("was removed")
+			continue
+		}
+		if prev.name != next.name {
+			report("name changed from %q to %q", prev.name, next.name)
+		}
+		if prev.input != next.input {
+			report("input type changed from %s to %s", prev.input, next.input)
+		}
+		if prev.output != next.output {
+			report("output type changed from %s to %s", prev.output, next.output)
+		}
+		if prev.id != next.id {
+			report("id expression changed from %q to %q", prev.id, next.id)
+		}
+		for _, ref := range removed(prev.queries, next.queries) {
+			report("no longer supports query %s", ref)
+		}
+		for _, ref := range removed(prev.signals, next.signals) {
+			report("no longer supports signal %s", ref)
+		}
+		for _, ref := range removed(prev.updates, next.updates) {
+			report("no longer supports update %s", ref)
+		}
+	}
+	return changes, nil
+}
+
+// contracts returns the temporal definitions in the given descriptor set keyed by kind and
+// fully qualified rpc method name
+func contracts(set *descriptorpb.FileDescriptorSet) (map[string]*contract, error) {
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, err
+	}
+
+	results := map[string]*contract{}
+	add := func(kind string, method protoreflect.MethodDescriptor, prefix, custom string) *contract {
+		c := &contract{
+			kind:   kind,
+			method: method.FullName(),
+			name:   prefix + temporalName(method, custom, kindSuffix[kind]),
+			input:  method.Input().FullName(),
+			output: method.Output().FullName(),
+		}
+		results[fmt.Sprintf("%s %s", method.FullName(), kind)] = c
+		return c
+	}
+
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		fileOpts, _ := proto.GetExtension(file.Options(), temporalv1.E_File).(*temporalv1.FileOptions)
+		for i := 0; i < file.Services().Len(); i++ {
+			service := file.Services().Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				methodOpts := method.Options()
+				if opts, ok := proto.GetExtension(methodOpts, temporalv1.E_Activity).(*temporalv1.ActivityOptions); ok && opts != nil {
+					add("activity", method, fileOpts.GetActivityNamePrefix(), opts.GetName())
+				}
+				if opts, ok := proto.GetExtension(methodOpts, temporalv1.E_Query).(*temporalv1.QueryOptions); ok && opts != nil {
+					add("query", method, "", "")
+				}
+				if opts, ok := proto.GetExtension(methodOpts, temporalv1.E_Signal).(*temporalv1.SignalOptions); ok && opts != nil {
+					add("signal", method, "", "")
+				}
+				if opts, ok := proto.GetExtension(methodOpts, temporalv1.E_Update).(*temporalv1.UpdateOptions); ok && opts != nil {
+					add("update", method, "", "")
+				}
+				if opts, ok := proto.GetExtension(methodOpts, temporalv1.E_Workflow).(*temporalv1.WorkflowOptions); ok && opts != nil {
+					c := add("workflow", method, fileOpts.GetWorkflowNamePrefix(), opts.GetName())
+					c.id = opts.GetDefaultOptions().GetId()
+					for _, query := range opts.GetQuery() {
+						c.queries = append(c.queries, query.GetRef())
+					}
+					for _, signal := range opts.GetSignal() {
+						c.signals = append(c.signals, signal.GetRef())
+					}
+					for _, update := range opts.GetUpdate() {
+						c.updates = append(c.updates, update.GetRef())
+					}
+				}
+			}
+		}
+		return true
+	})
+	return results, nil
+}
+
+// kindSuffix describes the default name suffix for each kind of temporal definition
+var kindSuffix = map[string]string{
+	"activity": "Activity",
+	"query":    "Query",
+	"signal":   "Signal",
+	"update":   "Update",
+	"workflow": "Workflow",
+}
+
+// removed returns the items in prev that are not in next
+func removed(prev, next []string) (items []string) {
+	remaining := make(map[string]bool, len(next))
+	for _, item := range next {
+		remaining[item] = true
+	}
+	for _, item := range prev {
+		if !remaining[item] {
+			items = append(items, item)
+		}
+	}
+	return items
+}
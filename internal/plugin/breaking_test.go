@@ -0,0 +1,44 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestBreakingChanges(t *testing.T) {
+	base := testDescriptorSet(simple.File_simple_simple_proto, nil)
+
+	changes, err := BreakingChanges(base, testDescriptorSet(simple.File_simple_simple_proto, nil))
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	head := testDescriptorSet(simple.File_simple_simple_proto, func(fd *descriptorpb.FileDescriptorProto) {
+		workflow1 := testMethod(t, fd, "SomeWorkflow1")
+		opts := proto.GetExtension(workflow1.GetOptions(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+		opts.Name = "mycompany.simple.SomeWorkflowOne"
+		opts.Signal = opts.Signal[:1]
+		proto.SetExtension(workflow1.GetOptions(), temporalv1.E_Workflow, opts)
+
+		workflow3 := testMethod(t, fd, "SomeWorkflow3")
+		opts = proto.GetExtension(workflow3.GetOptions(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+		opts.DefaultOptions.Id = "some-workflow-3/${!id}"
+		proto.SetExtension(workflow3.GetOptions(), temporalv1.E_Workflow, opts)
+
+		proto.ClearExtension(testMethod(t, fd, "SomeActivity1").GetOptions(), temporalv1.E_Activity)
+		testMethod(t, fd, "SomeQuery2").InputType = proto.String(".mycompany.simple.SomeQuery1Response")
+	})
+	changes, err = BreakingChanges(base, head)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`activity mycompany.simple.Simple.SomeActivity1 was removed`,
+		`query mycompany.simple.Simple.SomeQuery2 input type changed from mycompany.simple.SomeQuery2Request to mycompany.simple.SomeQuery1Response`,
+		`workflow mycompany.simple.Simple.SomeWorkflow1 name changed from "mycompany.simple.SomeWorkflow1" to "mycompany.simple.SomeWorkflowOne"`,
+		`workflow mycompany.simple.Simple.SomeWorkflow1 no longer supports signal SomeSignal2`,
+		`workflow mycompany.simple.Simple.SomeWorkflow3 id expression changed from "some-workflow-3/${!id}/${!requestVal}" to "some-workflow-3/${!id}"`,
+	}, changes)
+}
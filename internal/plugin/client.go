@@ -8,12 +8,13 @@ import (
 	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
 	g "github.com/dave/jennifer/jen"
 	pgs "github.com/lyft/protoc-gen-star/v2"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // genClientInterface generates a Client interface for a given service
 func (svc *Service) genClientInterface(f *g.File) {
-	f.Commentf("Client describes a client for a %s worker", svc.GoName)
-	f.Type().Id("Client").InterfaceFunc(func(methods *g.Group) {
+	f.Commentf("%s describes a client for a %s worker", svc.names.client, svc.GoName)
+	f.Type().Id(svc.names.client).InterfaceFunc(func(methods *g.Group) {
 		for _, workflow := range svc.workflowsOrdered {
 			opts := svc.workflows[workflow]
 
@@ -32,12 +33,12 @@ func (svc *Service) genClientInterface(f *g.File) {
 					args.Id("ctx").Qual("context", "Context")
 					args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(messageType(method.Input))
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(messageType(method.Output))
 					}
 					returnVals.Error()
 				})
@@ -49,7 +50,7 @@ func (svc *Service) genClientInterface(f *g.File) {
 					args.Id("ctx").Qual("context", "Context")
 					args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 					if hasInput {
-						args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(messageType(method.Input))
 					}
 				}).
 				Params(
@@ -87,10 +88,10 @@ func (svc *Service) genClientInterface(f *g.File) {
 						args.Id("ctx").Qual("context", "Context")
 						args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 						if hasWorkflowInput {
-							args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+							args.Id("req").Op("*").Add(messageType(method.Input))
 						}
 						if hasSignalInput {
-							args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+							args.Id("signal").Op("*").Add(messageType(handler.Input))
 						}
 					}).
 					Params(
@@ -98,6 +99,11 @@ func (svc *Service) genClientInterface(f *g.File) {
 						g.Error(),
 					)
 			}
+
+			// add Create<Workflow>Schedule and Get<Workflow>Schedule methods
+			if opts.GetSchedule() != nil {
+				svc.genClientScheduleInterfaceMethods(methods, workflow)
+			}
 		}
 
 		// add <Query> methods
@@ -111,11 +117,11 @@ func (svc *Service) genClientInterface(f *g.File) {
 					args.Id("workflowID").String()
 					args.Id("runID").String()
 					if hasInput {
-						args.Id("query").Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Id("query").Op("*").Add(messageType(handler.Input))
 					}
 				}).
 				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
+					g.Op("*").Add(messageType(handler.Output)),
 					g.Error(),
 				)
 		}
@@ -131,11 +137,34 @@ func (svc *Service) genClientInterface(f *g.File) {
 					args.Id("workflowID").String()
 					args.Id("runID").String()
 					if hasInput {
-						args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Id("signal").Op("*").Add(messageType(handler.Input))
 					}
 				}).
 				Params(g.Error())
 		}
+
+		// add <Update> methods
+		for _, update := range svc.updatesOrdered {
+			handler := svc.methods[update]
+			hasInput := !isEmpty(handler.Input)
+			hasOutput := !isEmpty(handler.Output)
+			methods.Commentf("Update%s sends a %s update to an existing workflow", update, update)
+			methods.Id(fmt.Sprintf("Update%s", update)).
+				ParamsFunc(func(args *g.Group) {
+					args.Id("ctx").Qual("context", "Context")
+					args.Id("workflowID").String()
+					args.Id("runID").String()
+					if hasInput {
+						args.Id("update").Op("*").Add(messageType(handler.Input))
+					}
+				}).
+				ParamsFunc(func(returnVals *g.Group) {
+					if hasOutput {
+						returnVals.Op("*").Add(messageType(handler.Output))
+					}
+					returnVals.Error()
+				})
+		}
 	})
 }
 
@@ -157,7 +186,7 @@ func (svc *Service) genClientWorkflowRunInterface(f *g.File, workflow string) {
 			Params(g.Id("ctx").Qual("context", "Context")).
 			ParamsFunc(func(returnVals *g.Group) {
 				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+					returnVals.Op("*").Add(messageType(method.Output))
 				}
 				returnVals.Error()
 			})
@@ -171,11 +200,11 @@ func (svc *Service) genClientWorkflowRunInterface(f *g.File, workflow string) {
 				ParamsFunc(func(args *g.Group) {
 					args.Id("ctx").Qual("context", "Context")
 					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(messageType(handler.Input))
 					}
 				}).
 				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
+					g.Op("*").Add(messageType(handler.Output)),
 					g.Error(),
 				)
 		}
@@ -188,11 +217,31 @@ func (svc *Service) genClientWorkflowRunInterface(f *g.File, workflow string) {
 				ParamsFunc(func(args *g.Group) {
 					args.Id("ctx").Qual("context", "Context")
 					if hasInput {
-						args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Id("req").Op("*").Add(messageType(handler.Input))
 					}
 				}).
 				Params(g.Error())
 		}
+		for _, updateOpts := range opts.GetUpdate() {
+			update := updateOpts.GetRef()
+			handler := svc.methods[update]
+			hasInput := !isEmpty(handler.Input)
+			hasOutput := !isEmpty(handler.Output)
+			methods.Commentf("%s sends a %s update to the workflow", update, update)
+			methods.Id(update).
+				ParamsFunc(func(args *g.Group) {
+					args.Id("ctx").Qual("context", "Context")
+					if hasInput {
+						args.Id("req").Op("*").Add(messageType(handler.Input))
+					}
+				}).
+				ParamsFunc(func(returnVals *g.Group) {
+					if hasOutput {
+						returnVals.Op("*").Add(messageType(handler.Output))
+					}
+					returnVals.Error()
+				})
+		}
 	})
 }
 
@@ -206,7 +255,7 @@ func (svc *Service) genClientWorkflowRun(f *g.File, workflow string) {
 	f.Type().
 		Id(fmt.Sprintf("%sRun", name)).
 		Struct(
-			g.Id("client").Op("*").Id("workflowClient"),
+			g.Id("client").Op("*").Id(svc.names.workflowClient),
 			g.Id("run").Qual(clientPkg, "WorkflowRun"),
 		)
 }
@@ -254,13 +303,13 @@ func (svc *Service) genClientWorkflowRunGetMethod(f *g.File, workflow string) {
 		Params(g.Id("ctx").Qual("context", "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Output))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(messageType(method.Output))
 				fn.If(
 					g.Err().Op(":=").Id("r").Dot("run").Dot("Get").Call(
 						g.Id("ctx"),
@@ -300,11 +349,11 @@ func (svc *Service) genClientWorkflowRunQueryMethod(f *g.File, workflow string,
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(handler.Input))
 			}
 		}).
 		Params(
-			g.Op("*").Id(handler.Output.GoIdent.GoName),
+			g.Op("*").Add(messageType(handler.Output)),
 			g.Error(),
 		).
 		Block(
@@ -336,7 +385,7 @@ func (svc *Service) genClientWorkflowRunSignalMethod(f *g.File, workflow string,
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(handler.Input))
 			}
 		}).
 		Params(g.Error()).
@@ -354,61 +403,110 @@ func (svc *Service) genClientWorkflowRunSignalMethod(f *g.File, workflow string,
 		)
 }
 
+// genClientWorkflowRunUpdateMethod generates a <Workflow>Run's <Update> method
+func (svc *Service) genClientWorkflowRunUpdateMethod(f *g.File, workflow string, update string) {
+	method := svc.methods[workflow]
+	name := pgs.Name(method.GoName).LowerCamelCase().String()
+	handler := svc.methods[update]
+	hasInput := !isEmpty(handler.Input)
+	hasOutput := !isEmpty(handler.Output)
+
+	f.Commentf("%s sends a %s update to the workflow", update, update)
+	f.Func().
+		Params(g.Id("r").Op("*").Id(fmt.Sprintf("%sRun", name))).
+		Id(update).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(handler.Input))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(handler.Output))
+			}
+			returnVals.Error()
+		}).
+		Block(
+			g.Return(
+				g.Id("r").Dot("client").Dot(fmt.Sprintf("Update%s", update)).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					args.Id("r").Dot("ID").Call()
+					args.Lit("")
+					if hasInput {
+						args.Id("req")
+					}
+				}),
+			),
+		)
+}
+
 // genClient generates the client implementation
 func (svc *Service) genClient(f *g.File) {
-	f.Comment("Compile-time check that workflowClient satisfies Client")
-	f.Var().Op("_").Id("Client").Op("=").Op("&").Id("workflowClient").Block()
+	f.Commentf("Compile-time check that %s satisfies %s", svc.names.workflowClient, svc.names.client)
+	f.Var().Op("_").Id(svc.names.client).Op("=").Op("&").Id(svc.names.workflowClient).Block()
 
-	f.Commentf("workflowClient implements a temporal client for a %s service", svc.GoName)
+	f.Commentf("%s implements a temporal client for a %s service", svc.names.workflowClient, svc.GoName)
 	f.Type().
-		Id("workflowClient").
+		Id(svc.names.workflowClient).
 		StructFunc(func(fields *g.Group) {
 			fields.Id("client").Qual(clientPkg, "Client")
 		})
 }
 
 func (svc *Service) genClientConstructor(f *g.File) {
-	f.Commentf("NewClient initializes a new %s client", svc.GoName)
+	f.Commentf("%s initializes a new %s client", svc.names.newClient, svc.GoName)
 	f.Func().
-		Id("NewClient").
+		Id(svc.names.newClient).
 		Params(
 			g.Id("c").Qual(clientPkg, "Client"),
 		).
 		Params(
-			g.Id("Client"),
+			g.Id(svc.names.client),
 		).
 		Block(
 			g.Return(
-				g.Op("&").Id("workflowClient").Values(
+				g.Op("&").Id(svc.names.workflowClient).Values(
 					g.Id("client").Op(":").Id("c"),
 				),
 			),
 		)
 
-	f.Commentf("NewClientWithOptions initializes a new %s client with the given options", svc.GoName)
+	ns := svc.opts.GetNamespace()
+	if ns != "" {
+		f.Commentf("%s initializes a new %s client with the given options, defaulting to the %s namespace", svc.names.newClientWithOptions, svc.GoName, ns)
+	} else {
+		f.Commentf("%s initializes a new %s client with the given options", svc.names.newClientWithOptions, svc.GoName)
+	}
 	f.Func().
-		Id("NewClientWithOptions").
+		Id(svc.names.newClientWithOptions).
 		Params(
 			g.Id("c").Qual(clientPkg, "Client"),
 			g.Id("opts").Qual(clientPkg, "Options"),
 		).
 		Params(
-			g.Id("Client"),
+			g.Id(svc.names.client),
 			g.Error(),
 		).
-		Block(
-			g.Var().Err().Error(),
-			g.List(g.Id("c"), g.Err()).Op("=").Qual(clientPkg, "NewClientFromExisting").Call(g.Id("c"), g.Id("opts")),
-			g.If().Err().Op("!=").Nil().Block(
+		BlockFunc(func(fn *g.Group) {
+			// client starts do not support per-workflow namespaces, so apply the service default to the client
+			if ns != "" {
+				fn.If(g.Id("opts").Dot("Namespace").Op("==").Lit("")).Block(
+					g.Id("opts").Dot("Namespace").Op("=").Lit(ns),
+				)
+			}
+			fn.Var().Err().Error()
+			fn.List(g.Id("c"), g.Err()).Op("=").Qual(clientPkg, "NewClientFromExisting").Call(g.Id("c"), g.Id("opts"))
+			fn.If().Err().Op("!=").Nil().Block(
 				g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("error initializing client with options: %w"), g.Err())),
-			),
-			g.Return(
-				g.Op("&").Id("workflowClient").Values(
+			)
+			fn.Return(
+				g.Op("&").Id(svc.names.workflowClient).Values(
 					g.Id("client").Op(":").Id("c"),
 				),
 				g.Nil(),
-			),
-		)
+			)
+		})
 }
 
 // genClientWorkflow generates an <Workflow> client method
@@ -423,18 +521,18 @@ func (svc *Service) genClientWorkflow(f *g.File, workflow string) {
 		f.Commentf("%s executes a %s workflow and blocks until error or response received", workflow, workflow)
 	}
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(workflow).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Output))
 			}
 			returnVals.Error()
 		}).
@@ -468,13 +566,13 @@ func (svc *Service) genClientWorkflowExecute(f *g.File, workflow string) {
 	hasInput := !isEmpty(method.Input)
 	f.Commentf("Execute%s starts a %s workflow", workflow, workflow)
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(fmt.Sprintf("Execute%s", workflow)).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Params(
@@ -516,7 +614,7 @@ func (svc *Service) genClientWorkflowGet(f *g.File, workflow string) {
 	name := pgs.Name(method.GoName).LowerCamelCase().String()
 	f.Commentf("Get%s fetches an existing %s execution", workflow, workflow)
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(fmt.Sprintf("Get%s", workflow)).
 		Params(
 			g.Id("ctx").Qual("context", "Context"),
@@ -550,16 +648,16 @@ func (svc *Service) genClientSignalWithStart(f *g.File, workflow, signal string)
 	hasSignalInput := !isEmpty(handler.Input)
 	f.Commentf("%s starts a %s workflow and sends a %s signal in a transaction", name, workflow, signal)
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(name).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
 			if hasWorkflowInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 			if hasSignalInput {
-				args.Id("signal").Op("*").Id(handler.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(messageType(handler.Input))
 			}
 		}).
 		Params(
@@ -605,22 +703,22 @@ func (svc *Service) genClientQueryMethod(f *g.File, query string) {
 	hasInput := !isEmpty(method.Input)
 	f.Commentf("Query%s sends a %s query to an existing workflow", query, query)
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(fmt.Sprintf("Query%s", query)).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("query").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("query").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Params(
-			g.Op("*").Id(method.Output.GoIdent.GoName),
+			g.Op("*").Add(messageType(method.Output)),
 			g.Error(),
 		).
 		Block(
-			g.Var().Id("resp").Id(method.Output.GoIdent.GoName),
+			g.Var().Id("resp").Add(messageType(method.Output)),
 			g.If(
 				g.List(g.Id("val"), g.Err()).Op(":=").Id("c").Dot("client").Dot("QueryWorkflow").CallFunc(func(args *g.Group) {
 					args.Id("ctx")
@@ -654,14 +752,14 @@ func (svc *Service) genClientSignalMethod(f *g.File, signal string) {
 	hasInput := !isEmpty(method.Input)
 	f.Commentf("Signal%s sends a %s signal to an existing workflow", signal, signal)
 	f.Func().
-		Params(g.Id("c").Op("*").Id("workflowClient")).
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
 		Id(fmt.Sprintf("Signal%s", signal)).
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual("context", "Context")
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("signal").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("signal").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Params(g.Error()).
@@ -682,41 +780,85 @@ func (svc *Service) genClientSignalMethod(f *g.File, signal string) {
 		)
 }
 
+// genClientUpdateMethod adds a <Update> method to a workflowClient
+func (svc *Service) genClientUpdateMethod(f *g.File, update string) {
+	method := svc.methods[update]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+	f.Commentf("Update%s sends a %s update to an existing workflow", update, update)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
+		Id(fmt.Sprintf("Update%s", update)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			if hasInput {
+				args.Id("update").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(method.Output))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("handle"), g.Err()).Op(":=").Id("c").Dot("client").Dot("UpdateWorkflow").CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				args.Id("workflowID")
+				args.Id("runID")
+				args.Id(fmt.Sprintf("%sUpdateName", update))
+				if hasInput {
+					args.Id("update")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.ReturnFunc(func(returnVals *g.Group) {
+					if hasOutput {
+						returnVals.Nil()
+					}
+					returnVals.Err()
+				}),
+			)
+			if hasOutput {
+				fn.Var().Id("resp").Add(messageType(method.Output))
+				fn.If(
+					g.Err().Op(":=").Id("handle").Dot("Get").Call(g.Id("ctx"), g.Op("&").Id("resp")),
+					g.Err().Op("!=").Nil(),
+				).Block(
+					g.Return(g.Nil(), g.Err()),
+				)
+				fn.Return(g.Op("&").Id("resp"), g.Nil())
+			} else {
+				fn.Return(g.Id("handle").Dot("Get").Call(g.Id("ctx"), g.Nil()))
+			}
+		})
+}
+
 // genStartWorkflowOptions adds logic for initializing StartWorkflowOptions with default values
 func (svc *Service) genStartWorkflowOptions(fn *g.Group, workflow string, child bool) {
 	method := svc.methods[workflow]
 	opts := svc.workflows[workflow]
 	hasInput := !isEmpty(method.Input)
+	defaults := svc.startWorkflowDefaults(workflow, child)
 
 	// initialize options if nil
 	fn.If(g.Id("opts").Op("==").Nil()).BlockFunc(func(bl *g.Group) {
 		if child {
 			bl.Id("childOpts").Op(":=").Qual(workflowPkg, "GetChildWorkflowOptions").Call(g.Id("ctx"))
+			genInheritedDefaults(bl, "childOpts", defaults)
 			bl.Id("opts").Op("=").Op("&").Id("childOpts")
 		} else {
 			bl.Id("opts").Op("=").Op("&").Qual(clientPkg, "StartWorkflowOptions").Block()
 		}
 	})
 
-	// set task queue if unset and default available
-	taskQueue := opts.GetDefaultOptions().GetTaskQueue()
-	if taskQueue == "" {
-		taskQueue = svc.opts.GetTaskQueue()
-	}
-	if taskQueue != "" {
-		fn.If(g.Id("opts").Dot("TaskQueue").Op("==").Lit("")).Block(
-			g.Id("opts").Dot("TaskQueue").Op("=").Lit(taskQueue),
-		)
-	}
-
-	idFieldName := "ID"
-	if child {
-		idFieldName = "WorkflowID"
-	}
-
 	// set workflow id if unset and  id field and/or prefix defined
-	if idExpr := opts.GetDefaultOptions().GetId(); idExpr != "" {
-		fn.If(g.Id("opts").Dot(idFieldName).Op("==").Lit("")).BlockFunc(func(b *g.Group) {
+	if idExpr := opts.GetDefaultOptions().GetId(); idExpr != "" && child {
+		svc.genChildWorkflowID(fn, workflow)
+	} else if idExpr != "" {
+		fn.If(g.Id("opts").Dot("ID").Op("==").Lit("")).BlockFunc(func(b *g.Group) {
 			b.List(g.Id("id"), g.Err()).Op(":=").Qual(expressionPkg, "EvalExpression").CallFunc(func(args *g.Group) {
 				args.Id(fmt.Sprintf("%sIDExpression", workflow))
 				if hasInput {
@@ -725,20 +867,110 @@ func (svc *Service) genStartWorkflowOptions(fn *g.Group, workflow string, child
 					args.Nil()
 				}
 			})
-			b.If(g.Err().Op("!=").Nil()).BlockFunc(func(returnVals *g.Group) {
-				if child {
-					returnVals.Panic(g.Err())
-				} else {
-					returnVals.Return(g.Nil(), g.Err())
-				}
-			})
-			b.Id("opts").Dot(idFieldName).Op("=").Id("id")
+			b.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			b.Id("opts").Dot("ID").Op("=").Id("id")
 		})
 	}
 
+	// set remaining defaults if unset
+	genUnsetDefaults(fn, defaults)
+}
+
+// genInheritedDefaults adds logic for applying inherited and boolean defaults to options initialized
+// from the workflow context. Context options inherit values from the current workflow, so these
+// defaults take precedence over them, but not over options provided by the caller
+func genInheritedDefaults(bl *g.Group, name string, defaults []optionDefault) {
+	for _, d := range defaults {
+		if d.inherited || d.zero == nil {
+			bl.Id(name).Dot(d.field).Op("=").Add(d.value)
+		}
+	}
+}
+
+// genUnsetDefaults adds logic for applying defaults to unset option fields
+func genUnsetDefaults(fn *g.Group, defaults []optionDefault) {
+	for _, d := range defaults {
+		if d.zero == nil {
+			continue
+		}
+		fn.If(g.Id("opts").Dot(d.field).Op("==").Add(d.zero)).Block(
+			g.Id("opts").Dot(d.field).Op("=").Add(d.value),
+		)
+	}
+}
+
+// optionDefault describes a default value for a field of a generated helper's options, where zero
+// is the field's unset value, or nil if unset values cannot be distinguished. Inherited fields are
+// populated in workflow context options from the current workflow
+type optionDefault struct {
+	field     string
+	value     g.Code
+	zero      g.Code
+	inherited bool
+}
+
+// durationDefault returns a default for the given duration field, if valid
+func durationDefault(field string, d *durationpb.Duration, inherited bool) []optionDefault {
+	if !d.IsValid() {
+		return nil
+	}
+	return []optionDefault{{
+		field:     field,
+		value:     g.Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10)).Comment(d.AsDuration().String()),
+		zero:      g.Lit(0),
+		inherited: inherited,
+	}}
+}
+
+// retryPolicyDefault returns a default for the RetryPolicy field, if defined
+func retryPolicyDefault(policy *temporalv1.RetryPolicy) []optionDefault {
+	if policy == nil {
+		return nil
+	}
+	return []optionDefault{{
+		field: "RetryPolicy",
+		value: g.Op("&").Qual(temporalPkg, "RetryPolicy").ValuesFunc(func(fields *g.Group) {
+			if d := policy.GetInitialInterval(); d.IsValid() {
+				fields.Id("InitialInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
+			}
+			if d := policy.GetMaxInterval(); d.IsValid() {
+				fields.Id("MaximumInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
+			}
+			if n := policy.GetBackoffCoefficient(); n != 0 {
+				fields.Id("BackoffCoefficient").Op(":").Lit(n)
+			}
+			if n := policy.GetMaxAttempts(); n != 0 {
+				fields.Id("MaximumAttempts").Op(":").Lit(n)
+			}
+			if errs := policy.GetNonRetryableErrorTypes(); len(errs) > 0 {
+				fields.Id("NonRetryableErrorTypes").Op(":").Lit(errs)
+			}
+		}),
+		zero: g.Nil(),
+	}}
+}
+
+// startWorkflowDefaults returns the default start options for the given workflow
+func (svc *Service) startWorkflowDefaults(workflow string, child bool) (defaults []optionDefault) {
+	opts := svc.workflowDefaultOptions(workflow)
+	if child {
+		opts = svc.childDefaultOptions(workflow)
+	}
+
+	// set task queue if unset and default available
+	taskQueue := opts.GetTaskQueue()
+	if taskQueue == "" {
+		taskQueue = svc.opts.GetTaskQueue()
+	}
+	if taskQueue != "" {
+		defaults = append(defaults, optionDefault{field: "TaskQueue", value: g.Lit(taskQueue), zero: g.Lit(""), inherited: true})
+	}
+
 	// set default id reuse policy
 	var idReusePolicy string
-	switch opts.GetDefaultOptions().GetIdReusePolicy() {
+	switch opts.GetIdReusePolicy() {
 	case temporalv1.IDReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE:
 		idReusePolicy = "WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE"
 	case temporalv1.IDReusePolicy_WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY:
@@ -748,86 +980,53 @@ func (svc *Service) genStartWorkflowOptions(fn *g.Group, workflow string, child
 	case temporalv1.IDReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING:
 		idReusePolicy = "WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING"
 	}
-
 	if idReusePolicy != "" {
-		fn.If(g.Id("opts").Dot("WorkflowIDReusePolicy").Op("==").Qual(enumsPkg, "WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED")).
-			Block(
-				g.Id("opts").Dot("WorkflowIDReusePolicy").Op("=").Qual(enumsPkg, idReusePolicy),
-			)
+		defaults = append(defaults, optionDefault{
+			field: "WorkflowIDReusePolicy",
+			value: g.Qual(enumsPkg, idReusePolicy),
+			zero:  g.Qual(enumsPkg, "WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED"),
+		})
 	}
 
-	if policy := opts.GetDefaultOptions().GetRetryPolicy(); policy != nil {
-		fn.If(g.Id("opts").Dot("RetryPolicy").Op("==").Nil()).Block(
-			g.Id("opts").Dot("RetryPolicy").Op("=").Op("&").Qual(temporalPkg, "RetryPolicy").ValuesFunc(func(fields *g.Group) {
-				if d := policy.GetInitialInterval(); d.IsValid() {
-					fields.Id("InitialInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
-				}
-				if d := policy.GetMaxInterval(); d.IsValid() {
-					fields.Id("MaximumInterval").Op(":").Id(strconv.FormatInt(d.AsDuration().Nanoseconds(), 10))
-				}
-				if n := policy.GetBackoffCoefficient(); n != 0 {
-					fields.Id("BackoffCoefficient").Op(":").Lit(n)
-				}
-				if n := policy.GetMaxAttempts(); n != 0 {
-					fields.Id("MaximumAttempts").Op(":").Lit(n)
-				}
-				if errs := policy.GetNonRetryableErrorTypes(); len(errs) > 0 {
-					fields.Id("NonRetryableErrorTypes").Op(":").Lit(errs)
-				}
-			}),
-		)
-	}
+	defaults = append(defaults, retryPolicyDefault(opts.GetRetryPolicy())...)
+	defaults = append(defaults, durationDefault("WorkflowExecutionTimeout", opts.GetExecutionTimeout(), true)...)
+	defaults = append(defaults, durationDefault("WorkflowRunTimeout", opts.GetRunTimeout(), true)...)
+	defaults = append(defaults, durationDefault("WorkflowTaskTimeout", opts.GetTaskTimeout(), true)...)
 
-	if timeout := opts.GetDefaultOptions().GetExecutionTimeout(); timeout.IsValid() {
-		fn.If(g.Id("opts").Dot("WorkflowExecutionTimeout").Op("==").Lit(0)).
-			Block(
-				g.Id("opts").Dot("WorkflowRunTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-			)
+	// client starts use the namespace of the client
+	if !child {
+		return defaults
 	}
 
-	if timeout := opts.GetDefaultOptions().GetRunTimeout(); timeout.IsValid() {
-		fn.If(g.Id("opts").Dot("WorkflowRunTimeout").Op("==").Lit(0)).
-			Block(
-				g.Id("opts").Dot("WorkflowRunTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-			)
+	// add child workflow default options
+	ns := opts.GetNamespace()
+	if ns == "" {
+		ns = svc.opts.GetNamespace()
 	}
-
-	if timeout := opts.GetDefaultOptions().GetTaskTimeout(); timeout.IsValid() {
-		fn.If(g.Id("opts").Dot("WorkflowTaskTimeout").Op("==").Lit(0)).
-			Block(
-				g.Id("opts").Dot("WorkflowRunTimeout").Op("=").Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)).Comment(timeout.AsDuration().String()),
-			)
+	if ns != "" {
+		defaults = append(defaults, optionDefault{field: "Namespace", value: g.Lit(ns), zero: g.Lit(""), inherited: true})
 	}
 
-	// add child workflow default options
-	if child {
-		ns := opts.GetDefaultOptions().GetNamespace()
-		if ns == "" {
-			ns = svc.opts.GetNamespace()
-		}
-		if ns != "" {
-			fn.If(g.Id("opts").Dot("Namespace").Op("==").Lit("")).Block(
-				g.Id("opts").Dot("Namespace").Op("=").Lit(ns),
-			)
-		}
-
-		var parentClosePolicy string
-		switch opts.GetDefaultOptions().GetParentClosePolicy() {
-		case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON:
-			parentClosePolicy = "PARENT_CLOSE_POLICY_ABANDON"
-		case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL:
-			parentClosePolicy = "PARENT_CLOSE_POLICY_REQUEST_CANCEL"
-		case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE:
-			parentClosePolicy = "PARENT_CLOSE_POLICY_TERMINATE"
-		}
-		if parentClosePolicy != "" {
-			fn.If(g.Id("opts").Dot("ParentClosePolicy").Op("==").Qual(enumsPkg, "PARENT_CLOSE_POLICY_UNSPECIFIED")).Block(
-				g.Id("opts").Dot("ParentClosePolicy").Op("=").Qual(enumsPkg, parentClosePolicy),
-			)
-		}
+	var parentClosePolicy string
+	switch opts.GetParentClosePolicy() {
+	case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON:
+		parentClosePolicy = "PARENT_CLOSE_POLICY_ABANDON"
+	case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL:
+		parentClosePolicy = "PARENT_CLOSE_POLICY_REQUEST_CANCEL"
+	case temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE:
+		parentClosePolicy = "PARENT_CLOSE_POLICY_TERMINATE"
+	}
+	if parentClosePolicy != "" {
+		defaults = append(defaults, optionDefault{
+			field: "ParentClosePolicy",
+			value: g.Qual(enumsPkg, parentClosePolicy),
+			zero:  g.Qual(enumsPkg, "PARENT_CLOSE_POLICY_UNSPECIFIED"),
+		})
+	}
 
-		if opts.GetDefaultOptions().GetWaitForCancellation() {
-			fn.Id("opts").Dot("WaitForCancellation").Op("=").Lit(true)
-		}
+	// boolean defaults can only be distinguished from explicit values when options are not provided
+	if opts != nil && opts.WaitForCancellation != nil {
+		defaults = append(defaults, optionDefault{field: "WaitForCancellation", value: g.Lit(opts.GetWaitForCancellation())})
 	}
+	return defaults
 }
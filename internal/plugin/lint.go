@@ -0,0 +1,107 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// uuidExpr matches a uuid_v4() function call in an id expression
+var uuidExpr = regexp.MustCompile(`uuid_v4\(\s*\)`)
+
+// lint reports temporal annotations that compile successfully but are likely to fail or
+// misbehave at runtime
+func lint(svcs []*Service) error {
+	var errs error
+	report := func(desc protoreflect.Descriptor, format string, args ...any) {
+		errs = errors.Join(errs, fmt.Errorf("%s: %s", location(desc), fmt.Sprintf(format, args...)))
+	}
+
+	workflowNames := map[string]string{}
+	activityNames := map[string]string{}
+	for _, svc := range svcs {
+		for _, method := range svc.Methods {
+			name := method.GoName
+			if svc.isTemporalMethod(name) && (method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer()) {
+				report(method.Desc, "%s %q is a streaming rpc, which is not supported by temporal", svc.methodKind(name), name)
+			}
+		}
+
+		for _, workflow := range svc.workflowsOrdered {
+			method := svc.methods[workflow]
+			opts := svc.workflows[workflow]
+
+			// ensure workflow names are unique
+			name := svc.workflowName(workflow)
+			if prev, ok := workflowNames[name]; ok {
+				report(method.Desc, "workflow %q name %q is already used by workflow %q", workflow, name, prev)
+			} else {
+				workflowNames[name] = workflow
+			}
+
+			// ensure signal-with-start can target an existing workflow
+			if id := opts.GetDefaultOptions().GetId(); uuidExpr.MatchString(id) {
+				for _, signalOpts := range opts.GetSignal() {
+					if signalOpts.GetStart() {
+						report(method.Desc, "workflow %q supports starting with signal %q, but its id expression %q uses uuid_v4(), so signals will never be delivered to an existing workflow", workflow, signalOpts.GetRef(), id)
+					}
+				}
+			}
+		}
+
+		for _, activity := range svc.activitiesOrdered {
+			method := svc.methods[activity]
+			opts := svc.activities[activity]
+
+			// ensure activity names are unique
+			name := svc.activityName(activity)
+			if prev, ok := activityNames[name]; ok {
+				report(method.Desc, "activity %q name %q is already used by activity %q", activity, name, prev)
+			} else {
+				activityNames[name] = activity
+			}
+
+			// ensure activities define a timeout, as temporal rejects activities without one
+			if opts.GetDefaultOptions().GetStartToCloseTimeout() == nil && opts.GetDefaultOptions().GetScheduleToCloseTimeout() == nil {
+				report(method.Desc, "activity %q must define a default start_to_close_timeout or schedule_to_close_timeout", activity)
+			}
+		}
+
+		for _, query := range svc.queriesOrdered {
+			if method := svc.methods[query]; isEmpty(method.Output) {
+				report(method.Desc, "query %q output must not be google.protobuf.Empty", query)
+			}
+		}
+	}
+	return errs
+}
+
+// methodKind describes the temporal definition type of the given method
+func (svc *Service) methodKind(name string) string {
+	if _, ok := svc.workflows[name]; ok {
+		return "workflow"
+	}
+	if _, ok := svc.activities[name]; ok {
+		return "activity"
+	}
+	if _, ok := svc.queries[name]; ok {
+		return "query"
+	}
+	if _, ok := svc.signals[name]; ok {
+		return "signal"
+	}
+	return "update"
+}
+
+// location returns the source location of the given descriptor in file:line:column format,
+// or the file path if source information is unavailable
+func location(desc protoreflect.Descriptor) string {
+	file := desc.ParentFile()
+	loc := file.SourceLocations().ByDescriptor(desc)
+	if loc.Path == nil {
+		return file.Path()
+	}
+	return fmt.Sprintf("%s:%d:%d", file.Path(), loc.StartLine+1, loc.StartColumn+1)
+}
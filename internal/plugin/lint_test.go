@@ -0,0 +1,49 @@
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	"github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestLint(t *testing.T) {
+	require.NoError(t, lint(parseTestServices(t, multiple.File_multiple_multiple_proto, nil)))
+
+	err := lint(parseTestServices(t, simple.File_simple_simple_proto, func(fd *descriptorpb.FileDescriptorProto) {
+		testMethod(t, fd, "SomeQuery1").OutputType = proto.String(".google.protobuf.Empty")
+		testMethod(t, fd, "SomeSignal1").ClientStreaming = proto.Bool(true)
+
+		workflow2 := testMethod(t, fd, "SomeWorkflow2")
+		opts := proto.GetExtension(workflow2.GetOptions(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+		opts.Name = "mycompany.simple.SomeWorkflow1"
+		opts.DefaultOptions = &temporalv1.WorkflowOptions_StartOptions{Id: "some-workflow-2/${!uuid_v4()}"}
+		proto.SetExtension(workflow2.GetOptions(), temporalv1.E_Workflow, opts)
+
+		activity2 := testMethod(t, fd, "SomeActivity2")
+		proto.SetExtension(activity2.GetOptions(), temporalv1.E_Activity, &temporalv1.ActivityOptions{
+			Name: "mycompany.simple.SomeActivity1",
+			DefaultOptions: &temporalv1.ActivityOptions_StartOptions{
+				ScheduleToCloseTimeout: durationpb.New(time.Minute),
+			},
+		})
+	}))
+	require.Error(t, err)
+	for _, msg := range []string{
+		`simple/simple.proto: activity "SomeActivity1" must define a default start_to_close_timeout or schedule_to_close_timeout`,
+		`simple/simple.proto: activity "SomeActivity2" name "mycompany.simple.SomeActivity1" is already used by activity "SomeActivity1"`,
+		`simple/simple.proto: query "SomeQuery1" output must not be google.protobuf.Empty`,
+		`simple/simple.proto: signal "SomeSignal1" is a streaming rpc, which is not supported by temporal`,
+		`simple/simple.proto: workflow "SomeWorkflow2" name "mycompany.simple.SomeWorkflow1" is already used by workflow "SomeWorkflow1"`,
+		`simple/simple.proto: workflow "SomeWorkflow2" supports starting with signal "SomeSignal1", but its id expression "some-workflow-2/${!uuid_v4()}" uses uuid_v4(), so signals will never be delivered to an existing workflow`,
+	} {
+		require.Contains(t, err.Error(), msg)
+	}
+	require.NotContains(t, err.Error(), `activity "SomeActivity2" must define`)
+}
@@ -0,0 +1,309 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// mockMethod describes an interface method implemented by a generated mock
+type mockMethod struct {
+	name         string
+	params       []mockParam
+	results      []*g.Statement
+	returnsError bool
+}
+
+// mockParam describes a named mock method parameter
+type mockParam struct {
+	name string
+	typ  *g.Statement
+}
+
+// renderMocks writes testify mocks for the service's Client and <Workflow>Run interfaces
+func (svc *Service) renderMocks(f *g.File) {
+	svc.genMock(f, svc.names.client, svc.clientMockMethods())
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genMock(f, fmt.Sprintf("%sRun", workflow), svc.workflowRunMockMethods(workflow))
+	}
+}
+
+// clientMockMethods returns the methods of the generated Client interface
+func (svc *Service) clientMockMethods() (methods []mockMethod) {
+	ctx := mockParam{"ctx", g.Qual("context", "Context")}
+	workflowID := mockParam{"workflowID", g.String()}
+	runID := mockParam{"runID", g.String()}
+	startOpts := mockParam{"opts", g.Op("*").Qual(clientPkg, "StartWorkflowOptions")}
+
+	for _, workflow := range svc.workflowsOrdered {
+		opts := svc.workflows[workflow]
+		method := svc.methods[workflow]
+		run := g.Id(fmt.Sprintf("%sRun", workflow))
+
+		params := []mockParam{ctx, startOpts}
+		if !isEmpty(method.Input) {
+			params = append(params, mockMessageParam("req", method.Input))
+		}
+		var results []*g.Statement
+		if !isEmpty(method.Output) {
+			results = append(results, mockMessageType(method.Output))
+		}
+		methods = append(methods,
+			mockMethod{name: workflow, params: params, results: results, returnsError: true},
+			mockMethod{name: fmt.Sprintf("Execute%s", workflow), params: params, results: []*g.Statement{run}, returnsError: true},
+			mockMethod{name: fmt.Sprintf("Get%s", workflow), params: []mockParam{ctx, workflowID, runID}, results: []*g.Statement{run}, returnsError: true},
+		)
+
+		for _, signalOpts := range opts.GetSignal() {
+			if !signalOpts.GetStart() {
+				continue
+			}
+			signal := signalOpts.GetRef()
+			handler := svc.methods[signal]
+			signalParams := append([]mockParam{}, params...)
+			if !isEmpty(handler.Input) {
+				signalParams = append(signalParams, mockMessageParam("signal", handler.Input))
+			}
+			methods = append(methods, mockMethod{name: fmt.Sprintf("Start%sWith%s", workflow, signal), params: signalParams, results: []*g.Statement{run}, returnsError: true})
+		}
+
+		if opts.GetSchedule() != nil {
+			handle := g.Id(fmt.Sprintf("%sScheduleHandle", workflow))
+			scheduleParams := []mockParam{ctx, {"schedule", g.Op("*").Qual(clientPkg, "ScheduleOptions")}, startOpts}
+			if !isEmpty(method.Input) {
+				scheduleParams = append(scheduleParams, mockMessageParam("req", method.Input))
+			}
+			methods = append(methods,
+				mockMethod{name: fmt.Sprintf("Create%sSchedule", workflow), params: scheduleParams, results: []*g.Statement{handle}, returnsError: true},
+				mockMethod{name: fmt.Sprintf("Get%sSchedule", workflow), params: []mockParam{ctx, {"scheduleID", g.String()}}, results: []*g.Statement{handle}, returnsError: true},
+			)
+		}
+	}
+
+	for _, query := range svc.queriesOrdered {
+		handler := svc.methods[query]
+		params := []mockParam{ctx, workflowID, runID}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("query", handler.Input))
+		}
+		methods = append(methods, mockMethod{name: fmt.Sprintf("Query%s", query), params: params, results: []*g.Statement{mockMessageType(handler.Output)}, returnsError: true})
+	}
+
+	for _, signal := range svc.signalsOrdered {
+		handler := svc.methods[signal]
+		params := []mockParam{ctx, workflowID, runID}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("signal", handler.Input))
+		}
+		methods = append(methods, mockMethod{name: fmt.Sprintf("Signal%s", signal), params: params, returnsError: true})
+	}
+
+	for _, update := range svc.updatesOrdered {
+		handler := svc.methods[update]
+		params := []mockParam{ctx, workflowID, runID}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("update", handler.Input))
+		}
+		var results []*g.Statement
+		if !isEmpty(handler.Output) {
+			results = append(results, mockMessageType(handler.Output))
+		}
+		methods = append(methods, mockMethod{name: fmt.Sprintf("Update%s", update), params: params, results: results, returnsError: true})
+	}
+	return methods
+}
+
+// workflowRunMockMethods returns the methods of a generated <Workflow>Run interface
+func (svc *Service) workflowRunMockMethods(workflow string) []mockMethod {
+	opts := svc.workflows[workflow]
+	method := svc.methods[workflow]
+	ctx := mockParam{"ctx", g.Qual("context", "Context")}
+
+	get := mockMethod{name: "Get", params: []mockParam{ctx}, returnsError: true}
+	if !isEmpty(method.Output) {
+		get.results = append(get.results, mockMessageType(method.Output))
+	}
+	methods := []mockMethod{
+		{name: "ID", results: []*g.Statement{g.String()}},
+		{name: "RunID", results: []*g.Statement{g.String()}},
+		get,
+	}
+
+	for _, queryOpts := range opts.GetQuery() {
+		query := queryOpts.GetRef()
+		handler := svc.methods[query]
+		params := []mockParam{ctx}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("req", handler.Input))
+		}
+		methods = append(methods, mockMethod{name: query, params: params, results: []*g.Statement{mockMessageType(handler.Output)}, returnsError: true})
+	}
+
+	for _, signalOpts := range opts.GetSignal() {
+		signal := signalOpts.GetRef()
+		handler := svc.methods[signal]
+		params := []mockParam{ctx}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("req", handler.Input))
+		}
+		methods = append(methods, mockMethod{name: signal, params: params, returnsError: true})
+	}
+
+	for _, updateOpts := range opts.GetUpdate() {
+		update := updateOpts.GetRef()
+		handler := svc.methods[update]
+		params := []mockParam{ctx}
+		if !isEmpty(handler.Input) {
+			params = append(params, mockMessageParam("req", handler.Input))
+		}
+		var results []*g.Statement
+		if !isEmpty(handler.Output) {
+			results = append(results, mockMessageType(handler.Output))
+		}
+		methods = append(methods, mockMethod{name: update, params: params, results: results, returnsError: true})
+	}
+	return methods
+}
+
+// genMock generates a Mock<Interface> type, constructor, methods, and typed expectation helpers
+func (svc *Service) genMock(f *g.File, iface string, methods []mockMethod) {
+	mockName := fmt.Sprintf("Mock%s", iface)
+
+	f.Commentf("Compile-time check that %s satisfies %s", mockName, iface)
+	f.Var().Op("_").Id(iface).Op("=").Op("&").Id(mockName).Block()
+
+	f.Commentf("%s is a testify mock implementation of %s", mockName, iface)
+	f.Type().Id(mockName).Struct(
+		g.Qual(mockPkg, "Mock"),
+	)
+
+	f.Commentf("New%s initializes a new %s that asserts its expectations when the test completes", mockName, mockName)
+	f.Func().
+		Id(fmt.Sprintf("New%s", mockName)).
+		Params(
+			g.Id("t").Interface(
+				g.Qual(mockPkg, "TestingT"),
+				g.Id("Cleanup").Params(g.Func().Params()),
+			),
+		).
+		Params(g.Op("*").Id(mockName)).
+		Block(
+			g.Id("m").Op(":=").Op("&").Id(mockName).Block(),
+			g.Id("m").Dot("Mock").Dot("Test").Call(g.Id("t")),
+			g.Id("t").Dot("Cleanup").Call(g.Func().Params().Block(
+				g.Id("m").Dot("AssertExpectations").Call(g.Id("t")),
+			)),
+			g.Return(g.Id("m")),
+		)
+
+	for _, method := range methods {
+		callName := fmt.Sprintf("%s%sCall", mockName, method.name)
+
+		// generate interface method
+		f.Commentf("%s implements %s.%s", method.name, iface, method.name)
+		f.Func().
+			Params(g.Id("m").Op("*").Id(mockName)).
+			Id(method.name).
+			ParamsFunc(func(args *g.Group) {
+				for _, param := range method.params {
+					args.Id(param.name).Add(param.typ)
+				}
+			}).
+			ParamsFunc(func(returnVals *g.Group) {
+				for _, result := range method.results {
+					returnVals.Add(result)
+				}
+				if method.returnsError {
+					returnVals.Error()
+				}
+			}).
+			BlockFunc(func(fn *g.Group) {
+				fn.Id("args").Op(":=").Id("m").Dot("Called").CallFunc(func(args *g.Group) {
+					for _, param := range method.params {
+						args.Id(param.name)
+					}
+				})
+				for i, result := range method.results {
+					fn.Var().Id(fmt.Sprintf("r%d", i)).Add(result)
+					fn.If(
+						g.Id("v").Op(":=").Id("args").Dot("Get").Call(g.Lit(i)),
+						g.Id("v").Op("!=").Nil(),
+					).Block(
+						g.Id(fmt.Sprintf("r%d", i)).Op("=").Id("v").Assert(result),
+					)
+				}
+				fn.ReturnFunc(func(returnVals *g.Group) {
+					for i := range method.results {
+						returnVals.Id(fmt.Sprintf("r%d", i))
+					}
+					if method.returnsError {
+						returnVals.Id("args").Dot("Error").Call(g.Lit(len(method.results)))
+					}
+				})
+			})
+
+		// generate On<Method> expectation helper
+		f.Commentf("On%s registers an expectation for a %s call", method.name, method.name)
+		f.Func().
+			Params(g.Id("m").Op("*").Id(mockName)).
+			Id(fmt.Sprintf("On%s", method.name)).
+			ParamsFunc(func(args *g.Group) {
+				for _, param := range method.params {
+					args.Id(param.name).Any()
+				}
+			}).
+			Params(g.Op("*").Id(callName)).
+			Block(
+				g.Return(g.Op("&").Id(callName).Values(
+					g.Id("Call").Op(":").Id("m").Dot("On").CallFunc(func(args *g.Group) {
+						args.Lit(method.name)
+						for _, param := range method.params {
+							args.Id(param.name)
+						}
+					}),
+				)),
+			)
+
+		// generate typed call wrapper
+		f.Commentf("%s wraps a %s expectation with typed return values", callName, method.name)
+		f.Type().Id(callName).Struct(
+			g.Op("*").Qual(mockPkg, "Call"),
+		)
+
+		f.Commentf("Return sets the values returned by a %s call", method.name)
+		f.Func().
+			Params(g.Id("c").Op("*").Id(callName)).
+			Id("Return").
+			ParamsFunc(func(args *g.Group) {
+				for i, result := range method.results {
+					args.Id(fmt.Sprintf("r%d", i)).Add(result)
+				}
+				if method.returnsError {
+					args.Err().Error()
+				}
+			}).
+			Params(g.Op("*").Id(callName)).
+			Block(
+				g.Id("c").Dot("Call").Dot("Return").CallFunc(func(args *g.Group) {
+					for i := range method.results {
+						args.Id(fmt.Sprintf("r%d", i))
+					}
+					if method.returnsError {
+						args.Err()
+					}
+				}),
+				g.Return(g.Id("c")),
+			)
+	}
+}
+
+// mockMessageParam returns a mock parameter for the given message type
+func mockMessageParam(name string, m *protogen.Message) mockParam {
+	return mockParam{name, mockMessageType(m)}
+}
+
+// mockMessageType returns a pointer reference to the given message type
+func mockMessageType(m *protogen.Message) *g.Statement {
+	return g.Op("*").Add(messageType(m))
+}
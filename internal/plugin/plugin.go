@@ -2,7 +2,11 @@ package plugin
 
 import (
 	"fmt"
+	"path"
 	"runtime"
+	"strconv"
+	"strings"
+	"unicode"
 
 	g "github.com/dave/jennifer/jen"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -13,65 +17,193 @@ type Plugin struct {
 	*protogen.Plugin
 	Commit  string
 	Version string
+	cfg     Config
+}
+
+// Config describes plugin configuration provided via generator parameters
+type Config struct {
+	// DisableActivities disables generation of activity registration and execution helpers
+	DisableActivities bool
+	// DisableChild disables generation of child workflow helpers
+	DisableChild bool
+	// DisableClient disables generation of the temporal client and workflow run types
+	DisableClient bool
+	// DisableWorker disables generation of workflow registration, signal, and worker helpers
+	DisableWorker bool
+	// GRPC enables generation of helpers that depend on protoc-gen-go-grpc output
+	GRPC bool
+	// Lint enables additional schema checks that fail generation when temporal annotations
+	// are likely to fail or misbehave at runtime
+	Lint bool
+	// Mocks enables generation of testify mocks for the client and workflow run interfaces
+	Mocks bool
+	// PackageSuffix, when non-empty, writes generated code to a sibling go package whose
+	// import path and name are those of the message package with the suffix appended
+	PackageSuffix string
+	// Prefix prefixes service-level identifiers with the service name, which is
+	// always the case when a go package contains multiple temporal services
+	Prefix bool
+	// Reproducible omits the go runtime version from the generated file header
+	Reproducible bool
+	// TestEnv enables generation of typed helpers for testsuite.TestWorkflowEnvironment
+	TestEnv bool
 }
 
 // Param provides a protogen ParamFunc handler
 func (p *Plugin) Param(key, value string) error {
+	var dst *bool
+	var invert bool
+	switch key {
+	case "activities":
+		dst, invert = &p.cfg.DisableActivities, true
+	case "child":
+		dst, invert = &p.cfg.DisableChild, true
+	case "client":
+		dst, invert = &p.cfg.DisableClient, true
+	case "grpc":
+		dst = &p.cfg.GRPC
+	case "lint":
+		dst = &p.cfg.Lint
+	case "mocks":
+		dst = &p.cfg.Mocks
+	case "package_suffix":
+		for _, r := range value {
+			if !unicode.IsLower(r) && !unicode.IsDigit(r) && r != '_' {
+				return fmt.Errorf("invalid %s parameter value %q: must contain only lowercase letters, digits, and underscores", key, value)
+			}
+		}
+		p.cfg.PackageSuffix = value
+		return nil
+	case "prefix":
+		dst = &p.cfg.Prefix
+	case "reproducible":
+		dst = &p.cfg.Reproducible
+	case "testenv":
+		dst = &p.cfg.TestEnv
+	case "worker":
+		dst, invert = &p.cfg.DisableWorker, true
+	default:
+		return fmt.Errorf("unknown parameter %q", key)
+	}
+
+	v, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid %s parameter value %q: %w", key, value, err)
+	}
+	*dst = v != invert
 	return nil
 }
 
 // Run defines the plugin entrypoint
 func (p *Plugin) Run(plugin *protogen.Plugin) error {
 	p.Plugin = plugin
-	servicesByPkg := map[string]int{}
 
+	// parse temporal services up front so that service-level identifiers can be
+	// prefixed when a go package contains more than one temporal service
+	var all []*Service
+	services := map[*protogen.File][]*Service{}
+	servicesByPkg := map[protogen.GoImportPath][]*Service{}
 	for _, file := range p.Files {
 		if !file.Generate {
 			continue
 		}
-
-		pkgName := string(file.GoPackageName)
-		f := g.NewFile(string(pkgName))
-		genCodeGenerationHeader(p, f, file)
-
-		var hasContent bool
 		for _, service := range file.Services {
-			svc, err := parseService(plugin, service)
+			svc, err := parseService(plugin, &p.cfg, file, service)
 			if err != nil {
 				return fmt.Errorf("error parsing service %s: %w", service.GoName, err)
 			}
-			if len(svc.activities) == 0 && len(svc.workflows) == 0 && len(svc.signals) == 0 && len(svc.queries) == 0 {
+			if len(svc.activities) == 0 && len(svc.workflows) == 0 && len(svc.signals) == 0 && len(svc.queries) == 0 && len(svc.updates) == 0 {
 				continue
 			}
+			all = append(all, svc)
+			services[file] = append(services[file], svc)
+			servicesByPkg[file.GoImportPath] = append(servicesByPkg[file.GoImportPath], svc)
+		}
+	}
 
-			if _, ok := servicesByPkg[string(file.GoPackageName)]; !ok {
-				servicesByPkg[pkgName] = 0
-			}
-			servicesByPkg[pkgName]++
-			if n := servicesByPkg[pkgName]; n > 1 {
-				return fmt.Errorf("only one temporal service per package is currently supported, observed violation for package: %s", pkgName)
+	for pkg, svcs := range servicesByPkg {
+		if len(svcs) < 2 {
+			continue
+		}
+		owners := map[string]string{}
+		for _, svc := range svcs {
+			svc.names = newServiceNames(svc.GoName, true)
+			for name := range svc.methods {
+				if !svc.isTemporalMethod(name) {
+					continue
+				}
+				if owner, ok := owners[name]; ok {
+					return fmt.Errorf("method %s is defined by both %s and %s services in package %s", name, owner, svc.GoName, pkg)
+				}
+				owners[name] = svc.GoName
 			}
-			svc.render(f)
-			hasContent = true
-			break
 		}
+	}
 
-		if !hasContent {
+	if p.cfg.Lint {
+		if err := lint(all); err != nil {
+			return fmt.Errorf("lint failed:\n%w", err)
+		}
+	}
+
+	for _, file := range p.Files {
+		svcs := services[file]
+		if len(svcs) == 0 {
 			continue
 		}
 
-		if err := f.Render(p.NewGeneratedFile(fmt.Sprintf("%s_temporal.pb.go", file.GeneratedFilenamePrefix), file.GoImportPath)); err != nil {
-			return fmt.Errorf("error rendering file: %w", err)
+		filename := fmt.Sprintf("%s_temporal.pb.go", file.GeneratedFilenamePrefix)
+		importPath, pkgName := file.GoImportPath, string(file.GoPackageName)
+		if suffix := p.cfg.PackageSuffix; suffix != "" {
+			dir := path.Dir(file.GeneratedFilenamePrefix)
+			if dir == "." {
+				return fmt.Errorf("package_suffix requires %s to be generated into a directory", file.Desc.Path())
+			}
+			filename = path.Join(dir+suffix, path.Base(filename))
+			importPath, pkgName = importPath+protogen.GoImportPath(suffix), pkgName+suffix
+		}
+
+		base := strings.TrimSuffix(filename, ".pb.go")
+		if err := p.renderFile(file, svcs, base+".pb.go", importPath, pkgName, (*Service).render); err != nil {
+			return err
+		}
+		if p.cfg.Mocks && !p.cfg.DisableClient {
+			if err := p.renderFile(file, svcs, base+"_mock.pb.go", importPath, pkgName, (*Service).renderMocks); err != nil {
+				return err
+			}
+		}
+		if p.cfg.TestEnv && !p.cfg.DisableWorker {
+			if err := p.renderFile(file, svcs, base+"_testenv.pb.go", importPath, pkgName, (*Service).renderTestEnv); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// renderFile renders the given services to a new generated file using the provided render function
+func (p *Plugin) renderFile(file *protogen.File, svcs []*Service, filename string, importPath protogen.GoImportPath, pkgName string, render func(*Service, *g.File)) error {
+	f := g.NewFilePathName(string(importPath), pkgName)
+	if importPath != file.GoImportPath {
+		f.ImportName(string(file.GoImportPath), string(file.GoPackageName))
+	}
+	genCodeGenerationHeader(p, f, file)
+	for _, svc := range svcs {
+		render(svc, f)
+	}
+	if err := f.Render(p.NewGeneratedFile(filename, importPath)); err != nil {
+		return fmt.Errorf("error rendering %s: %w", filename, err)
+	}
+	return nil
+}
+
 func genCodeGenerationHeader(p *Plugin, f *g.File, target *protogen.File) {
 	f.PackageComment("Code generated by protoc-gen-go_temporal. DO NOT EDIT.")
 	f.PackageComment("versions: ")
 	f.PackageComment(fmt.Sprintf("    protoc-gen-go_temporal %s (%s)", p.Version, p.Commit))
-	f.PackageComment(fmt.Sprintf("    go %s", runtime.Version()))
+	if !p.cfg.Reproducible {
+		f.PackageComment(fmt.Sprintf("    go %s", runtime.Version()))
+	}
 	compilerVersion := p.Plugin.Request.CompilerVersion
 	if compilerVersion != nil {
 		f.PackageComment(fmt.Sprintf("    protoc %s", compilerVersion.String()))
@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// testDescriptorSet returns a descriptor set containing the given file and its dependencies,
+// after applying the optional modify function to the file's descriptor
+func testDescriptorSet(fd protoreflect.FileDescriptor, modify func(*descriptorpb.FileDescriptorProto)) *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := map[string]bool{}
+	var add func(protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if seen[fd.Path()] {
+			return
+		}
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	add(fd)
+	if modify != nil {
+		modify(set.File[len(set.File)-1])
+	}
+	return set
+}
+
+// parseTestServices parses the temporal services defined in the given file, after applying
+// the optional modify function to its descriptor
+func parseTestServices(t *testing.T, fd protoreflect.FileDescriptor, modify func(*descriptorpb.FileDescriptorProto)) []*Service {
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fd.Path()},
+		ProtoFile:      testDescriptorSet(fd, modify).GetFile(),
+	}
+	p, err := protogen.Options{}.New(req)
+	require.NoError(t, err)
+
+	var svcs []*Service
+	for _, file := range p.Files {
+		if !file.Generate {
+			continue
+		}
+		for _, service := range file.Services {
+			svc, err := parseService(p, &Config{}, file, service)
+			require.NoError(t, err)
+			svcs = append(svcs, svc)
+		}
+	}
+	return svcs
+}
+
+// testMethod returns the named method of the first service in the given file descriptor
+func testMethod(t *testing.T, fd *descriptorpb.FileDescriptorProto, name string) *descriptorpb.MethodDescriptorProto {
+	for _, m := range fd.GetService()[0].GetMethod() {
+		if m.GetName() == name {
+			return m
+		}
+	}
+	require.FailNow(t, "method not found", name)
+	return nil
+}
@@ -0,0 +1,255 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	g "github.com/dave/jennifer/jen"
+	pgs "github.com/lyft/protoc-gen-star/v2"
+)
+
+// genClientScheduleInterfaceMethods adds Create<Workflow>Schedule and Get<Workflow>Schedule
+// methods to the Client interface
+func (svc *Service) genClientScheduleInterfaceMethods(methods *g.Group, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+
+	methods.Commentf("Create%sSchedule creates a schedule that periodically starts %s workflows", workflow, workflow)
+	methods.Id(fmt.Sprintf("Create%sSchedule", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("schedule").Op("*").Qual(clientPkg, "ScheduleOptions")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(
+			g.Id(fmt.Sprintf("%sScheduleHandle", workflow)),
+			g.Error(),
+		)
+
+	methods.Commentf("Get%sSchedule retrieves an existing %s schedule", workflow, workflow)
+	methods.Id(fmt.Sprintf("Get%sSchedule", workflow)).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("scheduleID").String(),
+		).
+		Params(
+			g.Id(fmt.Sprintf("%sScheduleHandle", workflow)),
+			g.Error(),
+		)
+}
+
+// genClientScheduleCreate generates a Create<Workflow>Schedule client method
+func (svc *Service) genClientScheduleCreate(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	name := pgs.Name(method.GoName).LowerCamelCase().String()
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Create%sSchedule creates a schedule that periodically starts %s workflows", workflow, workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
+		Id(fmt.Sprintf("Create%sSchedule", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("schedule").Op("*").Qual(clientPkg, "ScheduleOptions")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(
+			g.Id(fmt.Sprintf("%sScheduleHandle", workflow)),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			// initialize ScheduleOptions with defaults, where boolean defaults can only be
+			// distinguished from explicit values when options are not provided
+			pauseOnFailure := svc.workflows[workflow].GetSchedule().PauseOnFailure
+			fn.If(g.Id("schedule").Op("==").Nil()).Block(
+				g.Id("schedule").Op("=").Op("&").Qual(clientPkg, "ScheduleOptions").ValuesFunc(func(fields *g.Group) {
+					if pauseOnFailure != nil {
+						fields.Id("PauseOnFailure").Op(":").Lit(*pauseOnFailure)
+					}
+				}),
+			)
+			svc.genScheduleOptions(fn, workflow)
+
+			// initialize StartWorkflowOptions with defaults for the scheduled action
+			svc.genStartWorkflowOptions(fn, workflow, false)
+
+			fn.If(g.Id("schedule").Dot("ID").Op("==").Lit("")).Block(
+				g.Id("schedule").Dot("ID").Op("=").Id("opts").Dot("ID"),
+			)
+			fn.If(g.Id("schedule").Dot("Action").Op("==").Nil()).Block(
+				g.Id("schedule").Dot("Action").Op("=").Op("&").Qual(clientPkg, "ScheduleWorkflowAction").Values(g.DictFunc(func(fields g.Dict) {
+					fields[g.Id("ID")] = g.Id("opts").Dot("ID")
+					fields[g.Id("Workflow")] = g.Id(fmt.Sprintf("%sWorkflowName", workflow))
+					if hasInput {
+						fields[g.Id("Args")] = g.Index().Interface().Values(g.Id("req"))
+					}
+					for _, field := range []string{"TaskQueue", "WorkflowExecutionTimeout", "WorkflowRunTimeout", "WorkflowTaskTimeout", "RetryPolicy", "Memo", "SearchAttributes"} {
+						fields[g.Id(field)] = g.Id("opts").Dot(field)
+					}
+				})),
+			)
+
+			// create schedule
+			fn.List(g.Id("handle"), g.Err()).Op(":=").Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("Create").Call(
+				g.Id("ctx"), g.Op("*").Id("schedule"),
+			)
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(
+				g.Op("&").Id(fmt.Sprintf("%sScheduleHandle", name)).Values(
+					g.Id("ScheduleHandle").Op(":").Id("handle"),
+					g.Id("client").Op(":").Id("c"),
+				),
+				g.Nil(),
+			)
+		})
+}
+
+// genClientScheduleGet generates a Get<Workflow>Schedule client method
+func (svc *Service) genClientScheduleGet(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	name := pgs.Name(method.GoName).LowerCamelCase().String()
+
+	f.Commentf("Get%sSchedule retrieves an existing %s schedule", workflow, workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.workflowClient)).
+		Id(fmt.Sprintf("Get%sSchedule", workflow)).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("scheduleID").String(),
+		).
+		Params(
+			g.Id(fmt.Sprintf("%sScheduleHandle", workflow)),
+			g.Error(),
+		).
+		Block(
+			g.Return(
+				g.Op("&").Id(fmt.Sprintf("%sScheduleHandle", name)).Values(
+					g.Id("ScheduleHandle").Op(":").Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(g.Id("ctx"), g.Id("scheduleID")),
+					g.Id("client").Op(":").Id("c"),
+				),
+				g.Nil(),
+			),
+		)
+}
+
+// genClientScheduleHandle generates a <Workflow>ScheduleHandle interface and implementation
+func (svc *Service) genClientScheduleHandle(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	name := pgs.Name(method.GoName).LowerCamelCase().String()
+	handleName := fmt.Sprintf("%sScheduleHandle", workflow)
+	implName := fmt.Sprintf("%sScheduleHandle", name)
+	runName := fmt.Sprintf("%sRun", workflow)
+
+	f.Commentf("%s describes a schedule that periodically starts %s workflows", handleName, workflow)
+	f.Type().Id(handleName).Interface(
+		g.Qual(clientPkg, "ScheduleHandle"),
+		g.Line(),
+		g.Commentf("RunningWorkflows returns the %s workflows started by the schedule that are still running", workflow),
+		g.Id("RunningWorkflows").
+			Params(g.Id("ctx").Qual("context", "Context")).
+			Params(g.Index().Id(runName), g.Error()),
+	)
+
+	f.Commentf("%s provides an internal implementation of a %s", implName, handleName)
+	f.Type().Id(implName).Struct(
+		g.Qual(clientPkg, "ScheduleHandle"),
+		g.Id("client").Op("*").Id(svc.names.workflowClient),
+	)
+
+	f.Commentf("RunningWorkflows returns the %s workflows started by the schedule that are still running", workflow)
+	f.Func().
+		Params(g.Id("h").Op("*").Id(implName)).
+		Id("RunningWorkflows").
+		Params(g.Id("ctx").Qual("context", "Context")).
+		Params(g.Index().Id(runName), g.Error()).
+		Block(
+			g.List(g.Id("desc"), g.Err()).Op(":=").Id("h").Dot("Describe").Call(g.Id("ctx")),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			),
+			g.Id("runs").Op(":=").Make(g.Index().Id(runName), g.Lit(0), g.Len(g.Id("desc").Dot("Info").Dot("RunningWorkflows"))),
+			g.For(g.List(g.Id("_"), g.Id("execution")).Op(":=").Range().Id("desc").Dot("Info").Dot("RunningWorkflows")).Block(
+				g.List(g.Id("run"), g.Err()).Op(":=").Id("h").Dot("client").Dot(fmt.Sprintf("Get%s", workflow)).Call(
+					g.Id("ctx"), g.Id("execution").Dot("WorkflowID"), g.Lit(""),
+				),
+				g.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Nil(), g.Err()),
+				),
+				g.Id("runs").Op("=").Append(g.Id("runs"), g.Id("run")),
+			),
+			g.Return(g.Id("runs"), g.Nil()),
+		)
+}
+
+// genScheduleOptions adds logic for initializing ScheduleOptions with default values
+func (svc *Service) genScheduleOptions(fn *g.Group, workflow string) {
+	opts := svc.workflows[workflow].GetSchedule()
+
+	// set default spec if none provided
+	if len(opts.GetCron()) > 0 || len(opts.GetInterval()) > 0 {
+		fn.If(
+			g.Len(g.Id("schedule").Dot("Spec").Dot("Calendars")).Op("==").Lit(0).Op("&&").
+				Len(g.Id("schedule").Dot("Spec").Dot("Intervals")).Op("==").Lit(0).Op("&&").
+				Len(g.Id("schedule").Dot("Spec").Dot("CronExpressions")).Op("==").Lit(0),
+		).BlockFunc(func(bl *g.Group) {
+			if crons := opts.GetCron(); len(crons) > 0 {
+				bl.Id("schedule").Dot("Spec").Dot("CronExpressions").Op("=").Lit(crons)
+			}
+			if intervals := opts.GetInterval(); len(intervals) > 0 {
+				bl.Id("schedule").Dot("Spec").Dot("Intervals").Op("=").Index().Qual(clientPkg, "ScheduleIntervalSpec").ValuesFunc(func(items *g.Group) {
+					for _, interval := range intervals {
+						items.Line().ValuesFunc(func(fields *g.Group) {
+							fields.Id("Every").Op(":").Id(strconv.FormatInt(interval.GetEvery().AsDuration().Nanoseconds(), 10))
+							if offset := interval.GetOffset(); offset.IsValid() && offset.AsDuration() != 0 {
+								fields.Id("Offset").Op(":").Id(strconv.FormatInt(offset.AsDuration().Nanoseconds(), 10))
+							}
+						})
+					}
+					items.Line()
+				})
+			}
+		})
+	}
+
+	if jitter := opts.GetJitter(); jitter.IsValid() {
+		fn.If(g.Id("schedule").Dot("Spec").Dot("Jitter").Op("==").Lit(0)).Block(
+			g.Id("schedule").Dot("Spec").Dot("Jitter").Op("=").Id(strconv.FormatInt(jitter.AsDuration().Nanoseconds(), 10)).Comment(jitter.AsDuration().String()),
+		)
+	}
+
+	var overlapPolicy string
+	switch opts.GetOverlapPolicy() {
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_SKIP:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_SKIP"
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER_ONE:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_BUFFER_ONE"
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER_ALL:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_BUFFER_ALL"
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_CANCEL_OTHER"
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER"
+	case temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_ALLOW_ALL:
+		overlapPolicy = "SCHEDULE_OVERLAP_POLICY_ALLOW_ALL"
+	}
+	if overlapPolicy != "" {
+		fn.If(g.Id("schedule").Dot("Overlap").Op("==").Qual(enumsPkg, "SCHEDULE_OVERLAP_POLICY_UNSPECIFIED")).Block(
+			g.Id("schedule").Dot("Overlap").Op("=").Qual(enumsPkg, overlapPolicy),
+		)
+	}
+
+	if window := opts.GetCatchupWindow(); window.IsValid() {
+		fn.If(g.Id("schedule").Dot("CatchupWindow").Op("==").Lit(0)).Block(
+			g.Id("schedule").Dot("CatchupWindow").Op("=").Id(strconv.FormatInt(window.AsDuration().Nanoseconds(), 10)).Comment(window.AsDuration().String()),
+		)
+	}
+}
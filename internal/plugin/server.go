@@ -0,0 +1,149 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	g "github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// genServer generates a grpc server implementation backed by a temporal client
+func (svc *Service) genServer(f *g.File) {
+	serverName := fmt.Sprintf("%sServer", svc.GoName)
+
+	f.Commentf("Compile-time check that %s satisfies %s", svc.names.workflowServer, serverName)
+	f.Var().Op("_").Add(svc.grpcIdent(serverName)).Op("=").Op("&").Id(svc.names.workflowServer).Block()
+
+	f.Commentf("%s implements a %s that forwards requests to a %s client", svc.names.workflowServer, serverName, svc.GoName)
+	f.Type().
+		Id(svc.names.workflowServer).
+		StructFunc(func(fields *g.Group) {
+			fields.Add(svc.grpcIdent(fmt.Sprintf("Unimplemented%s", serverName)))
+			fields.Id("client").Id(svc.names.client)
+		})
+}
+
+// genServerConstructor generates a NewServer public function
+func (svc *Service) genServerConstructor(f *g.File) {
+	serverName := fmt.Sprintf("%sServer", svc.GoName)
+
+	f.Commentf("%s initializes a new %s that forwards requests to the given %s client. Target", svc.names.newServer, serverName, svc.GoName)
+	f.Comment("workflow and run ids are read from incoming gRPC metadata, falling back to the default id")
+	f.Comment("expression when starting workflows")
+	f.Func().
+		Id(svc.names.newServer).
+		Params(
+			g.Id("c").Id(svc.names.client),
+		).
+		Params(
+			svc.grpcIdent(serverName),
+		).
+		Block(
+			g.Return(
+				g.Op("&").Id(svc.names.workflowServer).Values(
+					g.Id("client").Op(":").Id("c"),
+				),
+			),
+		)
+}
+
+// genServerMethod generates a workflowServer method that forwards a request to the
+// corresponding temporal client method
+func (svc *Service) genServerMethod(f *g.File, method *protogen.Method) {
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		return
+	}
+	name := method.GoName
+	_, isWorkflow := svc.workflows[name]
+	_, isUpdate := svc.updates[name]
+	_, isQuery := svc.queries[name]
+	_, isSignal := svc.signals[name]
+	if !isWorkflow && !isUpdate && !isQuery && !isSignal {
+		return
+	}
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	if method.Comments.Leading.String() != "" {
+		f.Comment(strings.TrimSuffix(method.Comments.Leading.String(), "\n"))
+	} else {
+		f.Commentf("%s forwards a %s request to the temporal client", name, name)
+	}
+	f.Func().
+		Params(g.Id("s").Op("*").Id(svc.names.workflowServer)).
+		Id(name).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("req").Op("*").Add(messageType(method.Input)),
+		).
+		Params(
+			g.Op("*").Add(messageType(method.Output)),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			var clientMethod string
+			var returnsOutput bool
+			switch {
+			case isWorkflow:
+				clientMethod, returnsOutput = name, hasOutput
+			case isUpdate:
+				clientMethod, returnsOutput = fmt.Sprintf("Update%s", name), hasOutput
+			case isQuery:
+				clientMethod, returnsOutput = fmt.Sprintf("Query%s", name), true
+			default:
+				clientMethod = fmt.Sprintf("Signal%s", name)
+			}
+
+			// resolve target workflow
+			if !isWorkflow {
+				fn.List(g.Id("workflowID"), g.Err()).Op(":=").Qual(grpcutilPkg, "RequireWorkflowID").Call(g.Id("ctx"))
+				fn.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Nil(), g.Err()),
+				)
+			}
+
+			call := g.Id("s").Dot("client").Dot(clientMethod).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				if isWorkflow {
+					args.Op("&").Qual(clientPkg, "StartWorkflowOptions").Values(
+						g.Id("ID").Op(":").Qual(grpcutilPkg, "WorkflowID").Call(g.Id("ctx")),
+					)
+				} else {
+					args.Id("workflowID")
+					args.Qual(grpcutilPkg, "RunID").Call(g.Id("ctx"))
+				}
+				if hasInput {
+					args.Id("req")
+				}
+			})
+
+			if returnsOutput {
+				fn.List(g.Id("resp"), g.Err()).Op(":=").Add(call)
+				fn.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Nil(), g.Qual(grpcutilPkg, "ToStatusError").Call(g.Err())),
+				)
+				fn.Return(g.Id("resp"), g.Nil())
+			} else {
+				fn.If(g.Err().Op(":=").Add(call), g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Nil(), g.Qual(grpcutilPkg, "ToStatusError").Call(g.Err())),
+				)
+				fn.Return(g.Op("&").Add(messageType(method.Output)).Values(), g.Nil())
+			}
+		})
+}
+
+// grpcIdent returns a reference to an identifier generated by protoc-gen-go-grpc for
+// the service, qualified by the service's go import path
+func (svc *Service) grpcIdent(name string) *g.Statement {
+	return g.Qual(string(svc.goImportPath), name)
+}
+
+// messageType returns a reference to the given message type, qualified by its go
+// import path when it is declared outside of the generated package
+func messageType(m *protogen.Message) *g.Statement {
+	if isEmpty(m) {
+		return g.Qual(emptyPkg, "Empty")
+	}
+	return g.Qual(string(m.GoIdent.GoImportPath), m.GoIdent.GoName)
+}
@@ -4,55 +4,206 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
 	g "github.com/dave/jennifer/jen"
+	pgs "github.com/lyft/protoc-gen-star/v2"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // imported packages
 const (
-	activityPkg   = "go.temporal.io/sdk/activity"
-	clientPkg     = "go.temporal.io/sdk/client"
-	enumsPkg      = "go.temporal.io/api/enums/v1"
-	expressionPkg = "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
-	temporalPkg   = "go.temporal.io/sdk/temporal"
-	uuidPkg       = "github.com/google/uuid"
-	workflowPkg   = "go.temporal.io/sdk/workflow"
-	workerPkg     = "go.temporal.io/sdk/worker"
+	activityPkg     = "go.temporal.io/sdk/activity"
+	anyPkg          = "google.golang.org/protobuf/types/known/anypb"
+	clientPkg       = "go.temporal.io/sdk/client"
+	enumsPkg        = "go.temporal.io/api/enums/v1"
+	emptyPkg        = "google.golang.org/protobuf/types/known/emptypb"
+	expressionPkg   = "github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	grpcPkg         = "google.golang.org/grpc"
+	grpcutilPkg     = "github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	mockPkg         = "github.com/stretchr/testify/mock"
+	replayutilPkg   = "github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
+	serviceerrorPkg = "go.temporal.io/api/serviceerror"
+	temporalPkg     = "go.temporal.io/sdk/temporal"
+	testsuitePkg    = "go.temporal.io/sdk/testsuite"
+	uuidPkg         = "github.com/google/uuid"
+	workflowPkg     = "go.temporal.io/sdk/workflow"
+	workerPkg       = "go.temporal.io/sdk/worker"
 )
 
 // Service describes a temporal protobuf service definition
 type Service struct {
 	*protogen.Plugin
 	*protogen.Service
+	cfg               *Config
+	goImportPath      protogen.GoImportPath
+	fileOpts          *temporalv1.FileOptions
 	opts              *temporalv1.ServiceOptions
+	workflowDefaults  *temporalv1.WorkflowOptions_StartOptions
 	activitiesOrdered []string
 	activities        map[string]*temporalv1.ActivityOptions
 	methods           map[string]*protogen.Method
+	names             serviceNames
 	queriesOrdered    []string
 	queries           map[string]*temporalv1.QueryOptions
 	signalsOrdered    []string
 	signals           map[string]*temporalv1.SignalOptions
+	updatesOrdered    []string
+	updates           map[string]*temporalv1.UpdateOptions
 	workflowsOrdered  []string
 	workflows         map[string]*temporalv1.WorkflowOptions
 }
 
+// serviceNames describes the service-level identifiers used in generated code
+type serviceNames struct {
+	activities              string
+	client                  string
+	failedChildFuture       string
+	newActivitiesFromServer string
+	newClient               string
+	newClientWithOptions    string
+	newFailedChildFuture    string
+	newRemoteActivities     string
+	newServer               string
+	newTestClient           string
+	newTestEnv              string
+	registerActivities      string
+	registerWorkflows       string
+	remoteActivities        string
+	serverActivities        string
+	testClient              string
+	testClientRun           string
+	testEnv                 string
+	testEnvActivities       string
+	testUpdateCallbacks     string
+	workflowClient          string
+	workflowServer          string
+	workflows               string
+}
+
+// newServiceNames returns the service-level identifiers for the given service,
+// prefixing them with the service name when prefix is true so that multiple
+// services can be generated into the same package
+func newServiceNames(service string, prefix bool) serviceNames {
+	if !prefix {
+		return serviceNames{
+			activities:              "Activities",
+			client:                  "Client",
+			failedChildFuture:       "failedChildFuture",
+			newActivitiesFromServer: "NewActivitiesFromServer",
+			newClient:               "NewClient",
+			newClientWithOptions:    "NewClientWithOptions",
+			newFailedChildFuture:    "newFailedChildFuture",
+			newRemoteActivities:     "NewRemoteActivities",
+			newServer:               "NewServer",
+			newTestClient:           "NewTestClient",
+			newTestEnv:              "NewTestEnv",
+			registerActivities:      "RegisterActivities",
+			registerWorkflows:       "RegisterWorkflows",
+			remoteActivities:        "remoteActivities",
+			serverActivities:        "serverActivities",
+			testClient:              "TestClient",
+			testClientRun:           "testClientRun",
+			testEnv:                 "TestEnv",
+			testEnvActivities:       "testEnvActivities",
+			testUpdateCallbacks:     "testUpdateCallbacks",
+			workflowClient:          "workflowClient",
+			workflowServer:          "workflowServer",
+			workflows:               "Workflows",
+		}
+	}
+	// <Service>Client and New<Service>Client are reserved by protoc-gen-go-grpc
+	unexported := pgs.Name(service).LowerCamelCase().String()
+	return serviceNames{
+		activities:              service + "Activities",
+		client:                  service + "TemporalClient",
+		failedChildFuture:       unexported + "FailedChildFuture",
+		newActivitiesFromServer: "New" + service + "ActivitiesFromServer",
+		newClient:               "New" + service + "TemporalClient",
+		newClientWithOptions:    "New" + service + "TemporalClientWithOptions",
+		newFailedChildFuture:    "new" + service + "FailedChildFuture",
+		newRemoteActivities:     "New" + service + "RemoteActivities",
+		newServer:               "New" + service + "Server",
+		newTestClient:           "New" + service + "TestClient",
+		newTestEnv:              "New" + service + "TestEnv",
+		registerActivities:      "Register" + service + "Activities",
+		registerWorkflows:       "Register" + service + "Workflows",
+		remoteActivities:        unexported + "RemoteActivities",
+		serverActivities:        unexported + "ServerActivities",
+		testClient:              service + "TestClient",
+		testClientRun:           unexported + "TestClientRun",
+		testEnv:                 service + "TestEnv",
+		testEnvActivities:       unexported + "TestEnvActivities",
+		testUpdateCallbacks:     unexported + "TestUpdateCallbacks",
+		workflowClient:          unexported + "WorkflowClient",
+		workflowServer:          unexported + "WorkflowServer",
+		workflows:               service + "Workflows",
+	}
+}
+
 // parseService extracts a Service from a protogen.Service value
-func parseService(p *protogen.Plugin, service *protogen.Service) (*Service, error) {
+func parseService(p *protogen.Plugin, cfg *Config, file *protogen.File, service *protogen.Service) (*Service, error) {
 	svc := Service{
-		Plugin:     p,
-		Service:    service,
-		activities: make(map[string]*temporalv1.ActivityOptions),
-		methods:    make(map[string]*protogen.Method),
-		queries:    make(map[string]*temporalv1.QueryOptions),
-		signals:    make(map[string]*temporalv1.SignalOptions),
-		workflows:  make(map[string]*temporalv1.WorkflowOptions),
+		Plugin:       p,
+		Service:      service,
+		cfg:          cfg,
+		goImportPath: file.GoImportPath,
+		activities:   make(map[string]*temporalv1.ActivityOptions),
+		methods:      make(map[string]*protogen.Method),
+		names:        newServiceNames(service.GoName, cfg.Prefix),
+		queries:      make(map[string]*temporalv1.QueryOptions),
+		signals:      make(map[string]*temporalv1.SignalOptions),
+		updates:      make(map[string]*temporalv1.UpdateOptions),
+		workflows:    make(map[string]*temporalv1.WorkflowOptions),
+	}
+
+	var errs error
+	if opts, ok := proto.GetExtension(file.Desc.Options(), temporalv1.E_File).(*temporalv1.FileOptions); ok && opts != nil {
+		svc.fileOpts = opts
+
+		// ensure file defaults do not define ids shared by every activity or workflow
+		if opts.GetDefaultActivityOptions().GetActivityId() != "" {
+			errs = errors.Join(errs, fmt.Errorf("file %q default_activity_options must not define an activity_id", file.Desc.Path()))
+		}
+		if opts.GetDefaultWorkflowOptions().GetId() != "" {
+			errs = errors.Join(errs, fmt.Errorf("file %q default_workflow_options must not define an id", file.Desc.Path()))
+		}
 	}
 
 	if opts, ok := proto.GetExtension(service.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && opts != nil {
 		svc.opts = opts
+
+		// ensure service defaults do not define ids shared by every activity or child workflow
+		if opts.GetDefaultActivityOptions().GetActivityId() != "" {
+			errs = errors.Join(errs, fmt.Errorf("service %q default_activity_options must not define an activity_id", service.GoName))
+		}
+		if opts.GetDefaultChildOptions().GetId() != "" {
+			errs = errors.Join(errs, fmt.Errorf("service %q default_child_options must not define an id", service.GoName))
+		}
+	}
+
+	// cascade file defaults into service options
+	if svc.fileOpts != nil {
+		opts := &temporalv1.ServiceOptions{}
+		if svc.opts != nil {
+			opts = proto.Clone(svc.opts).(*temporalv1.ServiceOptions)
+		}
+		if opts.GetNamespace() == "" {
+			opts.Namespace = svc.fileOpts.GetNamespace()
+		}
+		opts.DefaultActivityOptions = mergeOptions(svc.fileOpts.GetDefaultActivityOptions(), opts.GetDefaultActivityOptions())
+		opts.DefaultChildOptions = mergeOptions(svc.fileOpts.GetDefaultWorkflowOptions(), opts.GetDefaultChildOptions())
+		svc.opts = opts
+
+		// file workflow defaults are resolved separately from workflow default_options, so that
+		// service default_child_options take precedence over them for child workflows
+		if defaults := svc.fileOpts.GetDefaultWorkflowOptions(); defaults != nil {
+			svc.workflowDefaults = proto.Clone(defaults).(*temporalv1.WorkflowOptions_StartOptions)
+		}
 	}
 
 	for _, method := range service.Methods {
@@ -60,6 +211,8 @@ func parseService(p *protogen.Plugin, service *protogen.Service) (*Service, erro
 		svc.methods[name] = method
 
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Activity).(*temporalv1.ActivityOptions); ok && opts != nil {
+			opts = proto.Clone(opts).(*temporalv1.ActivityOptions)
+			opts.DefaultOptions = mergeOptions(svc.opts.GetDefaultActivityOptions(), opts.GetDefaultOptions())
 			svc.activities[name] = opts
 			svc.activitiesOrdered = append(svc.activitiesOrdered, name)
 		}
@@ -74,17 +227,36 @@ func parseService(p *protogen.Plugin, service *protogen.Service) (*Service, erro
 			svc.signalsOrdered = append(svc.signalsOrdered, name)
 		}
 
+		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions); ok && opts != nil {
+			svc.updates[name] = opts
+			svc.updatesOrdered = append(svc.updatesOrdered, name)
+		}
+
 		if opts, ok := proto.GetExtension(method.Desc.Options(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions); ok && opts != nil {
-			svc.workflows[name] = opts
+			svc.workflows[name] = proto.Clone(opts).(*temporalv1.WorkflowOptions)
 			svc.workflowsOrdered = append(svc.workflowsOrdered, name)
 		}
 	}
 	sort.Strings(svc.activitiesOrdered)
 	sort.Strings(svc.queriesOrdered)
 	sort.Strings(svc.signalsOrdered)
+	sort.Strings(svc.updatesOrdered)
 	sort.Strings(svc.workflowsOrdered)
 
-	var errs error
+	// prefix task queues once all defaults have been resolved
+	if prefix := svc.fileOpts.GetTaskQueuePrefix(); prefix != "" {
+		prefixTaskQueue(prefix, svc.opts)
+		prefixTaskQueue(prefix, svc.opts.GetDefaultActivityOptions())
+		prefixTaskQueue(prefix, svc.opts.GetDefaultChildOptions())
+		prefixTaskQueue(prefix, svc.workflowDefaults)
+		for _, opts := range svc.activities {
+			prefixTaskQueue(prefix, opts.GetDefaultOptions())
+		}
+		for _, opts := range svc.workflows {
+			prefixTaskQueue(prefix, opts.GetDefaultOptions())
+		}
+	}
+
 	for _, workflow := range svc.workflowsOrdered {
 		opts := svc.workflows[workflow]
 
@@ -103,6 +275,59 @@ func parseService(p *protogen.Plugin, service *protogen.Service) (*Service, erro
 				errs = errors.Join(errs, fmt.Errorf("workflow  %q references undefined query: %q", workflow, query))
 			}
 		}
+
+		// ensure workflow id expression is valid and references defined input fields
+		if id := opts.GetDefaultOptions().GetId(); id != "" {
+			errs = errors.Join(errs, validateIDExpression(svc.methods[workflow], "workflow", id))
+		}
+
+		// ensure workflow patches are valid and unique
+		patches := make(map[string]string, len(opts.GetPatches()))
+		for _, patch := range opts.GetPatches() {
+			name := patchName(patch)
+			if name == "" {
+				errs = errors.Join(errs, fmt.Errorf("workflow  %q defines invalid patch: %q", workflow, patch))
+				continue
+			}
+			if prev, ok := patches[name]; ok {
+				errs = errors.Join(errs, fmt.Errorf("workflow  %q patch %q conflicts with patch %q", workflow, patch, prev))
+			}
+			patches[name] = patch
+		}
+
+		// ensure continue-as-new threshold is valid
+		if can := opts.GetContinueAsNew(); can != nil && can.GetMaxHistoryEvents() <= 0 {
+			errs = errors.Join(errs, fmt.Errorf("workflow  %q continue_as_new must define a positive max_history_events", workflow))
+		}
+
+		// ensure schedule intervals are valid
+		for _, interval := range opts.GetSchedule().GetInterval() {
+			if every := interval.GetEvery(); !every.IsValid() || every.AsDuration() <= 0 {
+				errs = errors.Join(errs, fmt.Errorf("workflow  %q schedule interval must define a positive period", workflow))
+			}
+		}
+
+		// ensure workflow updates are defined
+		for _, updateOpts := range opts.GetUpdate() {
+			update := updateOpts.GetRef()
+			if _, ok := svc.updates[update]; !ok {
+				errs = errors.Join(errs, fmt.Errorf("workflow  %q references undefined update: %q", workflow, update))
+			}
+		}
+	}
+	for _, activity := range svc.activitiesOrdered {
+		method := svc.methods[activity]
+		if id := svc.activities[activity].GetDefaultOptions().GetActivityId(); id != "" {
+			errs = errors.Join(errs, validateIDExpression(method, "activity", id))
+		}
+		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			switch {
+			case svc.activities[activity].GetRemote():
+				errs = errors.Join(errs, fmt.Errorf("remote activity %q must be a unary rpc", activity))
+			case cfg.GRPC && !cfg.DisableActivities:
+				errs = errors.Join(errs, fmt.Errorf("activity %q must be a unary rpc when grpc is enabled", activity))
+			}
+		}
 	}
 	for _, signal := range svc.signalsOrdered {
 		handler := svc.methods[signal]
@@ -116,98 +341,314 @@ func parseService(p *protogen.Plugin, service *protogen.Service) (*Service, erro
 	return &svc, errs
 }
 
-// render writes the temporal service to the given File
-func (svc *Service) render(f *g.File) {
-	svc.genConstants(f)
+// workflowDefaultOptions returns the default options for the given workflow, with unset fields
+// populated from the file default_workflow_options
+func (svc *Service) workflowDefaultOptions(workflow string) *temporalv1.WorkflowOptions_StartOptions {
+	return mergeOptions(svc.workflowDefaults, svc.workflows[workflow].GetDefaultOptions())
+}
 
-	// generate client interface and implementation
-	svc.genClientInterface(f)
-	svc.genClient(f)
-	svc.genClientConstructor(f)
+// childDefaultOptions returns the default options for the given workflow when started as a
+// child workflow, with unset fields populated from the service default_child_options, which
+// include the file default_workflow_options
+func (svc *Service) childDefaultOptions(workflow string) *temporalv1.WorkflowOptions_StartOptions {
+	return mergeOptions(svc.opts.GetDefaultChildOptions(), svc.workflows[workflow].GetDefaultOptions())
+}
 
-	// generate client workflow methods
-	for _, workflow := range svc.workflowsOrdered {
-		opts := svc.workflows[workflow]
-		svc.genClientWorkflow(f, workflow)
-		svc.genClientWorkflowExecute(f, workflow)
-		svc.genClientWorkflowGet(f, workflow)
-		for _, signal := range opts.GetSignal() {
-			if signal.GetStart() {
-				svc.genClientSignalWithStart(f, workflow, signal.GetRef())
+// mergeOptions returns a copy of opts with unset fields populated from defaults. Message
+// fields defined by opts, such as timeouts and retry policies, replace the default value
+// rather than being merged with it
+func mergeOptions[T proto.Message](defaults, opts T) T {
+	if !defaults.ProtoReflect().IsValid() {
+		return opts
+	}
+	merged := proto.Clone(defaults)
+	if src := opts.ProtoReflect(); src.IsValid() {
+		dst := merged.ProtoReflect()
+		src.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if fd.Message() != nil {
+				dst.Clear(fd)
 			}
-		}
+			return true
+		})
+		proto.Merge(merged, opts)
 	}
+	return merged.(T)
+}
 
-	// generate client query methods
-	for _, query := range svc.queriesOrdered {
-		svc.genClientQueryMethod(f, query)
+// prefixTaskQueue prepends the given prefix to the task_queue field of the given options,
+// if defined
+func prefixTaskQueue(prefix string, opts proto.Message) {
+	msg := opts.ProtoReflect()
+	if !msg.IsValid() {
+		return
+	}
+	fd := msg.Descriptor().Fields().ByName("task_queue")
+	if taskQueue := msg.Get(fd).String(); taskQueue != "" {
+		msg.Set(fd, protoreflect.ValueOfString(prefix+taskQueue))
 	}
+}
 
-	// generate client signal methods
-	for _, signal := range svc.signalsOrdered {
-		svc.genClientSignalMethod(f, signal)
+// isTemporalMethod returns true if the given method is a temporal activity, query, signal, update, or workflow
+func (svc *Service) isTemporalMethod(name string) bool {
+	_, isActivity := svc.activities[name]
+	_, isQuery := svc.queries[name]
+	_, isSignal := svc.signals[name]
+	_, isUpdate := svc.updates[name]
+	_, isWorkflow := svc.workflows[name]
+	return isActivity || isQuery || isSignal || isUpdate || isWorkflow
+}
+
+// activityName returns the registered name of the given activity
+func (svc *Service) activityName(activity string) string {
+	return svc.fileOpts.GetActivityNamePrefix() + temporalName(svc.methods[activity].Desc, svc.activities[activity].GetName(), "Activity")
+}
+
+// queryName returns the name of the given query
+func (svc *Service) queryName(query string) string {
+	return temporalName(svc.methods[query].Desc, "", "Query")
+}
+
+// signalName returns the name of the given signal
+func (svc *Service) signalName(signal string) string {
+	return temporalName(svc.methods[signal].Desc, "", "Signal")
+}
+
+// updateName returns the name of the given update
+func (svc *Service) updateName(update string) string {
+	return temporalName(svc.methods[update].Desc, "", "Update")
+}
+
+// workflowName returns the registered name of the given workflow
+func (svc *Service) workflowName(workflow string) string {
+	return svc.fileOpts.GetWorkflowNamePrefix() + temporalName(svc.methods[workflow].Desc, svc.workflows[workflow].GetName(), "Workflow")
+}
+
+// temporalName returns the custom name, if set, or a default name derived from the method's
+// fully qualified name and the given definition suffix
+func temporalName(method protoreflect.MethodDescriptor, custom, suffix string) string {
+	if custom != "" {
+		return custom
 	}
+	return fmt.Sprintf("%s%s", string(method.FullName()), suffix)
+}
 
-	// generate <Workflow>Run interfaces and implementations used by client
+// validateIDExpression returns an error if the given id expression is invalid or references fields
+// that are not defined on the method input
+func validateIDExpression(method *protogen.Method, kind, id string) (errs error) {
+	expr, err := expression.ParseExpression(id)
+	if err != nil {
+		return fmt.Errorf("%s: %s %q defines invalid id expression: %w", location(method.Desc), kind, method.GoName, err)
+	}
+	paths, err := expr.Paths()
+	if err != nil {
+		return fmt.Errorf("%s: %s %q id expression %q could not be validated: %w", location(method.Desc), kind, method.GoName, id, err)
+	}
+	for _, path := range paths {
+		if err := validateFieldPath(method.Input.Desc, path); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %s %q id expression %q references undefined field: %w", location(method.Desc), kind, method.GoName, id, err))
+		}
+	}
+	return errs
+}
+
+// validateFieldPath returns an error if the given path of json field names does not resolve to a
+// field of the message. Paths are resolved until a scalar, list, or map field is reached
+func validateFieldPath(msg protoreflect.MessageDescriptor, path []string) error {
+	for i, name := range path {
+		field := msg.Fields().ByJSONName(name)
+		if field == nil {
+			if field = msg.Fields().ByName(protoreflect.Name(name)); field != nil {
+				return fmt.Errorf("%s field %q must be referenced by its json name %q", msg.FullName(), strings.Join(path[:i+1], "."), field.JSONName())
+			}
+			return fmt.Errorf("%s does not define field %q", msg.FullName(), strings.Join(path[:i+1], "."))
+		}
+		if field.Message() == nil || field.IsList() || field.IsMap() {
+			return nil
+		}
+		msg = field.Message()
+	}
+	return nil
+}
+
+// carriesSignals returns true if the given workflow carries pending signals over to new runs
+func (svc *Service) carriesSignals(workflow string) bool {
+	opts := svc.workflows[workflow]
+	return opts.GetContinueAsNew().GetCarrySignals() && len(opts.GetSignal()) > 0
+}
+
+// isCarriedSignal returns true if the given signal is carried over to new runs by any workflow
+func (svc *Service) isCarriedSignal(signal string) bool {
 	for _, workflow := range svc.workflowsOrdered {
-		opts := svc.workflows[workflow]
-		svc.genClientWorkflowRunInterface(f, workflow)
-		svc.genClientWorkflowRun(f, workflow)
-		svc.genClientWorkflowRunIDMethod(f, workflow)
-		svc.genClientWorkflowRunRunIDMethod(f, workflow)
-		svc.genClientWorkflowRunGetMethod(f, workflow)
+		if !svc.carriesSignals(workflow) {
+			continue
+		}
+		for _, signalOpts := range svc.workflows[workflow].GetSignal() {
+			if signalOpts.GetRef() == signal {
+				return true
+			}
+		}
+	}
+	return false
+}
 
-		// generate query methods
-		for _, queryOpts := range opts.GetQuery() {
-			svc.genClientWorkflowRunQueryMethod(f, workflow, queryOpts.GetRef())
+// render writes the temporal service to the given File
+func (svc *Service) render(f *g.File) {
+	svc.genConstants(f)
+
+	// generate client interface and implementation
+	if !svc.cfg.DisableClient {
+		svc.genClientInterface(f)
+		svc.genClient(f)
+		svc.genClientConstructor(f)
+
+		// generate client workflow methods
+		for _, workflow := range svc.workflowsOrdered {
+			opts := svc.workflows[workflow]
+			svc.genClientWorkflow(f, workflow)
+			svc.genClientWorkflowExecute(f, workflow)
+			svc.genClientWorkflowGet(f, workflow)
+			for _, signal := range opts.GetSignal() {
+				if signal.GetStart() {
+					svc.genClientSignalWithStart(f, workflow, signal.GetRef())
+				}
+			}
+			if opts.GetSchedule() != nil {
+				svc.genClientScheduleCreate(f, workflow)
+				svc.genClientScheduleGet(f, workflow)
+			}
 		}
 
-		// generate signal methods
-		for _, signalOpts := range opts.GetSignal() {
-			svc.genClientWorkflowRunSignalMethod(f, workflow, signalOpts.GetRef())
+		// generate client query methods
+		for _, query := range svc.queriesOrdered {
+			svc.genClientQueryMethod(f, query)
+		}
+
+		// generate client signal methods
+		for _, signal := range svc.signalsOrdered {
+			svc.genClientSignalMethod(f, signal)
+		}
+
+		// generate client update methods
+		for _, update := range svc.updatesOrdered {
+			svc.genClientUpdateMethod(f, update)
+		}
+
+		// generate <Workflow>Run interfaces and implementations used by client
+		for _, workflow := range svc.workflowsOrdered {
+			opts := svc.workflows[workflow]
+			svc.genClientWorkflowRunInterface(f, workflow)
+			svc.genClientWorkflowRun(f, workflow)
+			svc.genClientWorkflowRunIDMethod(f, workflow)
+			svc.genClientWorkflowRunRunIDMethod(f, workflow)
+			svc.genClientWorkflowRunGetMethod(f, workflow)
+
+			// generate query methods
+			for _, queryOpts := range opts.GetQuery() {
+				svc.genClientWorkflowRunQueryMethod(f, workflow, queryOpts.GetRef())
+			}
+
+			// generate signal methods
+			for _, signalOpts := range opts.GetSignal() {
+				svc.genClientWorkflowRunSignalMethod(f, workflow, signalOpts.GetRef())
+			}
+
+			// generate update methods
+			for _, updateOpts := range opts.GetUpdate() {
+				svc.genClientWorkflowRunUpdateMethod(f, workflow, updateOpts.GetRef())
+			}
+		}
+
+		// generate <Workflow>ScheduleHandle interfaces and implementations used by client
+		for _, workflow := range svc.workflowsOrdered {
+			if svc.workflows[workflow].GetSchedule() != nil {
+				svc.genClientScheduleHandle(f, workflow)
+			}
 		}
 	}
 
 	// generate workflows interface and registration helper
-	svc.genWorkflowsInterface(f)
-	svc.genRegisterWorkflows(f)
+	if !svc.cfg.DisableWorker {
+		svc.genWorkflowsInterface(f)
+		svc.genRegisterWorkflows(f)
+		svc.genReplayer(f)
+	}
 
 	// generate workflow types, methods, functions
 	for _, workflow := range svc.workflowsOrdered {
-		svc.genRegisterWorkflow(f, workflow)
-		svc.genWorkflowWorkerBuilderFunction(f, workflow)
-		svc.genWorkflowWorker(f, workflow)
-		svc.genWorkflowWorkerExecuteMethod(f, workflow)
-		svc.genWorkflowInput(f, workflow)
-		svc.genWorkflowInterface(f, workflow)
-		svc.genExecuteChildWorkflow(f, workflow)
-		svc.genWorkflowChildRun(f, workflow)
-		svc.genWorkflowChildRunGet(f, workflow)
-		svc.genWorkflowChildRunSelect(f, workflow)
-		svc.genWorkflowChildRunSelectStart(f, workflow)
-		svc.genWorkflowChildRunWaitStart(f, workflow)
-		svc.genWorkflowChildRunSignals(f, workflow)
+		if !svc.cfg.DisableWorker {
+			svc.genRegisterWorkflow(f, workflow)
+			svc.genWorkflowWorkerBuilderFunction(f, workflow)
+			svc.genWorkflowWorker(f, workflow)
+			svc.genWorkflowWorkerExecuteMethod(f, workflow)
+			svc.genWorkflowInput(f, workflow)
+			svc.genWorkflowInterface(f, workflow)
+		}
+		if !svc.cfg.DisableChild {
+			svc.genExecuteChildWorkflow(f, workflow)
+			svc.genWorkflowChildRun(f, workflow)
+			svc.genWorkflowChildRunGet(f, workflow)
+			svc.genWorkflowChildRunSelect(f, workflow)
+			svc.genWorkflowChildRunSelectStart(f, workflow)
+			svc.genWorkflowChildRunWaitStart(f, workflow)
+			svc.genWorkflowChildRunSignals(f, workflow)
+		}
+		if !svc.cfg.DisableWorker {
+			svc.genWorkflowContinueAsNew(f, workflow)
+			for _, patch := range svc.workflows[workflow].GetPatches() {
+				svc.genWorkflowPatch(f, workflow, patch)
+			}
+		}
+	}
+
+	// generate failed child workflow future used when child workflow options cannot be initialized
+	if !svc.cfg.DisableChild && svc.hasChildIDExpression() {
+		svc.genFailedChildFuture(f)
 	}
 
 	// generate signal types, methods, functions
-	for _, signal := range svc.signalsOrdered {
-		svc.genWorkerSignal(f, signal)
-		svc.genWorkerSignalReceive(f, signal)
-		svc.genWorkerSignalReceiveAsync(f, signal)
-		svc.genWorkerSignalSelect(f, signal)
-		svc.genWorkerSignalExternal(f, signal)
+	if !svc.cfg.DisableWorker {
+		for _, signal := range svc.signalsOrdered {
+			svc.genWorkerSignal(f, signal)
+			svc.genWorkerSignalReceive(f, signal)
+			svc.genWorkerSignalReceiveAsync(f, signal)
+			svc.genWorkerSignalSelect(f, signal)
+			svc.genWorkerSignalExternal(f, signal)
+			if svc.isCarriedSignal(signal) {
+				svc.genWorkerSignalRestore(f, signal)
+				svc.genWorkerSignalDrain(f, signal)
+			}
+		}
 	}
 
 	// generate activities
-	svc.genActivitiesInterface(f)
-	svc.genRegisterActivities(f)
-	for _, activity := range svc.activitiesOrdered {
-		svc.genRegisterActivity(f, activity)
-		svc.genActivityFuture(f, activity)
-		svc.genActivityFutureGetMethod(f, activity)
-		svc.genActivityFutureSelectMethod(f, activity)
-		svc.genActivityFunction(f, activity, false)
-		svc.genActivityFunction(f, activity, true)
+	if !svc.cfg.DisableActivities {
+		svc.genActivitiesInterface(f)
+		svc.genRegisterActivities(f)
+		for _, activity := range svc.activitiesOrdered {
+			svc.genRegisterActivity(f, activity)
+			svc.genActivityFuture(f, activity)
+			svc.genActivityFutureGetMethod(f, activity)
+			svc.genActivityFutureSelectMethod(f, activity)
+			svc.genActivityFunction(f, activity, false)
+			if !svc.activities[activity].GetDisableLocal() {
+				svc.genActivityFunction(f, activity, true)
+			}
+		}
+		svc.genRemoteActivities(f)
+	}
+
+	// generate grpc server backed by temporal client
+	if svc.cfg.GRPC {
+		if !svc.cfg.DisableClient {
+			svc.genServer(f)
+			svc.genServerConstructor(f)
+			for _, method := range svc.Methods {
+				svc.genServerMethod(f, method)
+			}
+		}
+		if !svc.cfg.DisableActivities {
+			svc.genActivitiesFromServer(f)
+		}
 	}
 }
 
@@ -224,14 +665,7 @@ func (svc *Service) genConstants(f *g.File) {
 		f.Commentf("%s workflow names", svc.GoName)
 		f.Const().DefsFunc(func(defs *g.Group) {
 			for _, workflow := range svc.workflowsOrdered {
-				method := svc.methods[workflow]
-				opt := svc.workflows[workflow]
-				name := opt.GetName()
-				if name == "" {
-					name = fmt.Sprintf("%sWorkflow", string(method.Desc.FullName()))
-				}
-
-				defs.Id(fmt.Sprintf("%sWorkflowName", workflow)).Op("=").Lit(name)
+				defs.Id(fmt.Sprintf("%sWorkflowName", workflow)).Op("=").Lit(svc.workflowName(workflow))
 			}
 		})
 	}
@@ -258,8 +692,7 @@ func (svc *Service) genConstants(f *g.File) {
 		f.Commentf("%s query names", svc.GoName)
 		f.Const().DefsFunc(func(defs *g.Group) {
 			for _, query := range svc.queriesOrdered {
-				method := svc.methods[query]
-				defs.Id(fmt.Sprintf("%sQueryName", query)).Op("=").Lit(fmt.Sprintf("%sQuery", string(method.Desc.FullName())))
+				defs.Id(fmt.Sprintf("%sQueryName", query)).Op("=").Lit(svc.queryName(query))
 			}
 		})
 	}
@@ -269,8 +702,33 @@ func (svc *Service) genConstants(f *g.File) {
 		f.Commentf("%s signal names", svc.GoName)
 		f.Const().DefsFunc(func(defs *g.Group) {
 			for _, signal := range svc.signalsOrdered {
-				method := svc.methods[signal]
-				defs.Id(fmt.Sprintf("%sSignalName", signal)).Op("=").Lit(fmt.Sprintf("%sSignal", string(method.Desc.FullName())))
+				defs.Id(fmt.Sprintf("%sSignalName", signal)).Op("=").Lit(svc.signalName(signal))
+			}
+		})
+	}
+
+	// add update names
+	if len(svc.updates) > 0 {
+		f.Commentf("%s update names", svc.GoName)
+		f.Const().DefsFunc(func(defs *g.Group) {
+			for _, update := range svc.updatesOrdered {
+				defs.Id(fmt.Sprintf("%sUpdateName", update)).Op("=").Lit(svc.updateName(update))
+			}
+		})
+	}
+
+	// add patch change ids
+	var patches [][]string
+	for _, workflow := range svc.workflowsOrdered {
+		for _, patch := range svc.workflows[workflow].GetPatches() {
+			patches = append(patches, []string{workflow, patch})
+		}
+	}
+	if len(patches) > 0 {
+		f.Commentf("%s patch change ids", svc.GoName)
+		f.Const().DefsFunc(func(defs *g.Group) {
+			for _, pair := range patches {
+				defs.Id(fmt.Sprintf("%sPatch%sChangeID", pair[0], patchName(pair[1]))).Op("=").Lit(pair[1])
 			}
 		})
 	}
@@ -280,13 +738,23 @@ func (svc *Service) genConstants(f *g.File) {
 		f.Commentf("%s activity names", svc.GoName)
 		f.Const().DefsFunc(func(defs *g.Group) {
 			for _, activity := range svc.activitiesOrdered {
-				method := svc.methods[activity]
-				opts := svc.activities[activity]
-				name := opts.GetName()
-				if name == "" {
-					name = fmt.Sprintf("%sActivity", string(method.Desc.FullName()))
-				}
-				defs.Id(fmt.Sprintf("%sActivityName", activity)).Op("=").Lit(name)
+				defs.Id(fmt.Sprintf("%sActivityName", activity)).Op("=").Lit(svc.activityName(activity))
+			}
+		})
+	}
+
+	// add activity id expressions
+	var activityIDExpressions []string
+	for _, activity := range svc.activitiesOrdered {
+		if svc.activities[activity].GetDefaultOptions().GetActivityId() != "" {
+			activityIDExpressions = append(activityIDExpressions, activity)
+		}
+	}
+	if len(activityIDExpressions) > 0 && !svc.cfg.DisableActivities {
+		f.Commentf("%s activity id expressions", svc.GoName)
+		f.Var().DefsFunc(func(defs *g.Group) {
+			for _, activity := range activityIDExpressions {
+				defs.Id(fmt.Sprintf("%sActivityIDExpression", activity)).Op("=").Qual(expressionPkg, "MustParseExpression").Call(g.Lit(svc.activities[activity].GetDefaultOptions().GetActivityId()))
 			}
 		})
 	}
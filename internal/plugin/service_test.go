@@ -0,0 +1,240 @@
+package plugin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	temporalv1 "github.com/cludden/protoc-gen-go-temporal/gen/temporal/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestParseServiceIDExpressions(t *testing.T) {
+	cases := []struct {
+		workflow string
+		id       string
+		err      string
+	}{
+		{workflow: "SomeWorkflow1", id: "some-workflow-1/${!id}/${!outerSingle.innerSingle.bar}"},
+		{workflow: "SomeWorkflow1", id: "some-workflow-1/${!outerList.0.foo}/${!intField.string()}"},
+		{
+			workflow: "SomeWorkflow1",
+			id:       "some-workflow-1/${!id.uppercase(}",
+			err:      `simple/simple.proto: workflow "SomeWorkflow1" defines invalid id expression`,
+		},
+		{
+			workflow: "SomeWorkflow1",
+			id:       "some-workflow-1/${!outerSingle.missing}",
+			err:      `simple/simple.proto: workflow "SomeWorkflow1" id expression "some-workflow-1/${!outerSingle.missing}" references undefined field: mycompany.simple.SomeWorkflow1Request.OuterNested does not define field "outerSingle.missing"`,
+		},
+		{
+			workflow: "SomeWorkflow3",
+			id:       "some-workflow-3/${!request_val}",
+			err:      `mycompany.simple.SomeWorkflow3Request field "request_val" must be referenced by its json name "requestVal"`,
+		},
+		{
+			workflow: "SomeWorkflow2",
+			id:       "some-workflow-2/${!id}",
+			err:      `google.protobuf.Empty does not define field "id"`,
+		},
+	}
+
+	for _, c := range cases {
+		set := testDescriptorSet(simple.File_simple_simple_proto, func(fd *descriptorpb.FileDescriptorProto) {
+			method := testMethod(t, fd, c.workflow)
+			opts := proto.GetExtension(method.GetOptions(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+			opts.DefaultOptions = &temporalv1.WorkflowOptions_StartOptions{Id: c.id}
+			proto.SetExtension(method.GetOptions(), temporalv1.E_Workflow, opts)
+		})
+		p, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+			FileToGenerate: []string{simple.File_simple_simple_proto.Path()},
+			ProtoFile:      set.GetFile(),
+		})
+		require.NoError(t, err)
+
+		file := p.FilesByPath[simple.File_simple_simple_proto.Path()]
+		_, err = parseService(p, &Config{}, file, file.Services[0])
+		if c.err == "" {
+			require.NoError(t, err, c.id)
+		} else {
+			require.ErrorContains(t, err, c.err, c.id)
+		}
+	}
+}
+
+func TestParseServiceDefaultOptions(t *testing.T) {
+	serviceDefaults := func(activityOpts *temporalv1.ActivityOptions_StartOptions, childOpts *temporalv1.WorkflowOptions_StartOptions) func(*descriptorpb.FileDescriptorProto) {
+		return func(fd *descriptorpb.FileDescriptorProto) {
+			service := fd.GetService()[0]
+			opts := proto.GetExtension(service.GetOptions(), temporalv1.E_Service).(*temporalv1.ServiceOptions)
+			opts.DefaultActivityOptions = activityOpts
+			opts.DefaultChildOptions = childOpts
+			proto.SetExtension(service.GetOptions(), temporalv1.E_Service, opts)
+		}
+	}
+
+	svc := parseTestServices(t, simple.File_simple_simple_proto, serviceDefaults(
+		&temporalv1.ActivityOptions_StartOptions{
+			StartToCloseTimeout: durationpb.New(30 * time.Second),
+			HeartbeatTimeout:    durationpb.New(5 * time.Second),
+			RetryPolicy:         &temporalv1.RetryPolicy{MaxAttempts: 2},
+		},
+		&temporalv1.WorkflowOptions_StartOptions{
+			ExecutionTimeout:  durationpb.New(time.Hour),
+			ParentClosePolicy: temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL,
+		},
+	))[0]
+
+	// activities without default options use the service defaults
+	activity1 := svc.activities["SomeActivity1"].GetDefaultOptions()
+	require.Equal(t, 30*time.Second, activity1.GetStartToCloseTimeout().AsDuration())
+	require.Equal(t, int32(2), activity1.GetRetryPolicy().GetMaxAttempts())
+
+	// activity default options take precedence, and retry policies are not merged
+	activity2 := svc.activities["SomeActivity2"].GetDefaultOptions()
+	require.Equal(t, 10*time.Second, activity2.GetStartToCloseTimeout().AsDuration())
+	require.Equal(t, 5*time.Second, activity2.GetHeartbeatTimeout().AsDuration())
+	require.Equal(t, "some-activity-2", activity2.GetTaskQueue())
+	require.Equal(t, int32(0), activity2.GetRetryPolicy().GetMaxAttempts())
+	require.Equal(t, 30*time.Second, activity2.GetRetryPolicy().GetMaxInterval().AsDuration())
+
+	// child defaults apply to child workflows only
+	child1 := svc.childDefaultOptions("SomeWorkflow1")
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL, child1.GetParentClosePolicy())
+	require.Equal(t, time.Hour, child1.GetExecutionTimeout().AsDuration())
+	require.Equal(t, "some-workflow-1/${!id}/${!uuid_v4()}", child1.GetId())
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_UNSPECIFIED, svc.workflows["SomeWorkflow1"].GetDefaultOptions().GetParentClosePolicy())
+	child3 := svc.childDefaultOptions("SomeWorkflow3")
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON, child3.GetParentClosePolicy())
+
+	// service defaults must not define ids
+	set := testDescriptorSet(simple.File_simple_simple_proto, serviceDefaults(
+		&temporalv1.ActivityOptions_StartOptions{ActivityId: "activity/${!requestVal}"},
+		&temporalv1.WorkflowOptions_StartOptions{Id: "child/${!id}"},
+	))
+	p, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{simple.File_simple_simple_proto.Path()},
+		ProtoFile:      set.GetFile(),
+	})
+	require.NoError(t, err)
+	file := p.FilesByPath[simple.File_simple_simple_proto.Path()]
+	_, err = parseService(p, &Config{}, file, file.Services[0])
+	require.ErrorContains(t, err, `service "Simple" default_activity_options must not define an activity_id`)
+	require.ErrorContains(t, err, `service "Simple" default_child_options must not define an id`)
+}
+
+func TestParseServiceFileOptions(t *testing.T) {
+	fileDefaults := func(opts *temporalv1.FileOptions) func(*descriptorpb.FileDescriptorProto) {
+		return func(fd *descriptorpb.FileDescriptorProto) {
+			proto.SetExtension(fd.GetOptions(), temporalv1.E_File, opts)
+		}
+	}
+	modify := fileDefaults(&temporalv1.FileOptions{
+		Namespace:          "file-namespace",
+		TaskQueuePrefix:    "acme-",
+		WorkflowNamePrefix: "acme.",
+		ActivityNamePrefix: "acme.",
+		DefaultActivityOptions: &temporalv1.ActivityOptions_StartOptions{
+			StartToCloseTimeout: durationpb.New(time.Minute),
+		},
+		DefaultWorkflowOptions: &temporalv1.WorkflowOptions_StartOptions{
+			ExecutionTimeout: durationpb.New(2 * time.Hour),
+		},
+	})
+	svc := parseTestServices(t, simple.File_simple_simple_proto, modify)[0]
+
+	// file defaults cascade into service options
+	require.Equal(t, "file-namespace", svc.opts.GetNamespace())
+	require.Equal(t, "acme-my-task-queue", svc.opts.GetTaskQueue())
+
+	// and then into method options, where method options take precedence
+	require.Equal(t, time.Minute, svc.activities["SomeActivity1"].GetDefaultOptions().GetStartToCloseTimeout().AsDuration())
+	require.Equal(t, 10*time.Second, svc.activities["SomeActivity2"].GetDefaultOptions().GetStartToCloseTimeout().AsDuration())
+	require.Equal(t, "acme-some-activity-2", svc.activities["SomeActivity2"].GetDefaultOptions().GetTaskQueue())
+	require.Equal(t, 2*time.Hour, svc.workflowDefaultOptions("SomeWorkflow1").GetExecutionTimeout().AsDuration())
+	require.Equal(t, 2*time.Hour, svc.childDefaultOptions("SomeWorkflow1").GetExecutionTimeout().AsDuration())
+	require.Equal(t, time.Hour, svc.workflowDefaultOptions("SomeWorkflow3").GetExecutionTimeout().AsDuration())
+	require.Equal(t, "acme-my-task-queue-2", svc.workflowDefaultOptions("SomeWorkflow3").GetTaskQueue())
+
+	// child workflow options resolve file defaults, then service child defaults, then workflow defaults
+	layered := parseTestServices(t, simple.File_simple_simple_proto, func(fd *descriptorpb.FileDescriptorProto) {
+		fileDefaults(&temporalv1.FileOptions{
+			DefaultWorkflowOptions: &temporalv1.WorkflowOptions_StartOptions{
+				ExecutionTimeout:  durationpb.New(24 * time.Hour),
+				RunTimeout:        durationpb.New(12 * time.Hour),
+				TaskTimeout:       durationpb.New(time.Minute),
+				ParentClosePolicy: temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE,
+			},
+		})(fd)
+		service := fd.GetService()[0]
+		opts := proto.GetExtension(service.GetOptions(), temporalv1.E_Service).(*temporalv1.ServiceOptions)
+		opts.DefaultChildOptions = &temporalv1.WorkflowOptions_StartOptions{
+			ExecutionTimeout:  durationpb.New(time.Hour),
+			RunTimeout:        durationpb.New(time.Hour),
+			ParentClosePolicy: temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON,
+		}
+		proto.SetExtension(service.GetOptions(), temporalv1.E_Service, opts)
+	})[0]
+	child1 := layered.childDefaultOptions("SomeWorkflow1")
+	require.Equal(t, time.Hour, child1.GetExecutionTimeout().AsDuration())
+	require.Equal(t, time.Hour, child1.GetRunTimeout().AsDuration())
+	require.Equal(t, time.Minute, child1.GetTaskTimeout().AsDuration())
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON, child1.GetParentClosePolicy())
+	child3 := layered.childDefaultOptions("SomeWorkflow3")
+	require.Equal(t, time.Hour, child3.GetExecutionTimeout().AsDuration())
+	require.Equal(t, 30*time.Minute, child3.GetRunTimeout().AsDuration())
+	require.Equal(t, 30*time.Second, child3.GetTaskTimeout().AsDuration())
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON, child3.GetParentClosePolicy())
+	client1 := layered.workflowDefaultOptions("SomeWorkflow1")
+	require.Equal(t, 24*time.Hour, client1.GetExecutionTimeout().AsDuration())
+	require.Equal(t, temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE, client1.GetParentClosePolicy())
+
+	// name prefixes apply to default and custom names
+	require.Equal(t, "acme.mycompany.simple.SomeActivity1", svc.activityName("SomeActivity1"))
+	require.Equal(t, "acme.mycompany.simple.Simple.SomeActivity2Activity", svc.activityName("SomeActivity2"))
+	require.Equal(t, "acme.mycompany.simple.SomeWorkflow2", svc.workflowName("SomeWorkflow2"))
+	require.Equal(t, "mycompany.simple.Simple.SomeQuery1Query", svc.queryName("SomeQuery1"))
+
+	// and are reported as breaking changes
+	changes, err := BreakingChanges(testDescriptorSet(simple.File_simple_simple_proto, nil), testDescriptorSet(simple.File_simple_simple_proto, modify))
+	require.NoError(t, err)
+	require.Contains(t, changes, `workflow mycompany.simple.Simple.SomeWorkflow2 name changed from "mycompany.simple.SomeWorkflow2" to "acme.mycompany.simple.SomeWorkflow2"`)
+
+	// file defaults must not define ids
+	set := testDescriptorSet(simple.File_simple_simple_proto, fileDefaults(&temporalv1.FileOptions{
+		DefaultActivityOptions: &temporalv1.ActivityOptions_StartOptions{ActivityId: "activity/${!requestVal}"},
+		DefaultWorkflowOptions: &temporalv1.WorkflowOptions_StartOptions{Id: "workflow/${!id}"},
+	}))
+	p, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{simple.File_simple_simple_proto.Path()},
+		ProtoFile:      set.GetFile(),
+	})
+	require.NoError(t, err)
+	file := p.FilesByPath[simple.File_simple_simple_proto.Path()]
+	_, err = parseService(p, &Config{}, file, file.Services[0])
+	require.ErrorContains(t, err, `file "simple/simple.proto" default_activity_options must not define an activity_id`)
+	require.ErrorContains(t, err, `file "simple/simple.proto" default_workflow_options must not define an id`)
+}
+
+func TestParseServiceStreamingActivity(t *testing.T) {
+	set := testDescriptorSet(simple.File_simple_simple_proto, func(fd *descriptorpb.FileDescriptorProto) {
+		testMethod(t, fd, "SomeActivity1").ServerStreaming = proto.Bool(true)
+	})
+	p, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{simple.File_simple_simple_proto.Path()},
+		ProtoFile:      set.GetFile(),
+	})
+	require.NoError(t, err)
+
+	file := p.FilesByPath[simple.File_simple_simple_proto.Path()]
+	_, err = parseService(p, &Config{}, file, file.Services[0])
+	require.NoError(t, err)
+
+	_, err = parseService(p, &Config{GRPC: true}, file, file.Services[0])
+	require.ErrorContains(t, err, `activity "SomeActivity1" must be a unary rpc when grpc is enabled`)
+}
@@ -0,0 +1,732 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+// renderTestClient writes an in-memory Client implementation backed by TestEnv
+func (svc *Service) renderTestClient(f *g.File) {
+	svc.genTestClient(f)
+	svc.genTestClientConstructor(f)
+	svc.genTestClientRuns(f)
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genTestClientWorkflow(f, workflow)
+		svc.genTestClientWorkflowExecute(f, workflow)
+		svc.genTestClientWorkflowGet(f, workflow)
+		for _, signalOpts := range svc.workflows[workflow].GetSignal() {
+			if signalOpts.GetStart() {
+				svc.genTestClientSignalWithStart(f, workflow, signalOpts.GetRef())
+			}
+		}
+		if svc.workflows[workflow].GetSchedule() != nil {
+			svc.genTestClientSchedule(f, workflow)
+		}
+	}
+	for _, query := range svc.queriesOrdered {
+		svc.genTestClientQuery(f, query)
+	}
+	for _, signal := range svc.signalsOrdered {
+		svc.genTestClientSignal(f, signal)
+	}
+	for _, update := range svc.updatesOrdered {
+		svc.genTestClientUpdate(f, update)
+	}
+	svc.genTestClientRun(f)
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genTestClientWorkflowRun(f, workflow)
+	}
+}
+
+// genTestClient generates a TestClient struct
+func (svc *Service) genTestClient(f *g.File) {
+	f.Commentf("Compile-time check that %s satisfies %s", svc.names.testClient, svc.names.client)
+	f.Var().Op("_").Id(svc.names.client).Op("=").Op("&").Id(svc.names.testClient).Block()
+
+	f.Commentf("%s is an in-memory %s implementation that executes workflows using a %s.", svc.names.testClient, svc.names.client, svc.names.testEnv)
+	f.Comment("Signals sent before a workflow is executed are delivered when it starts, while Get, queries,")
+	f.Comment("and updates execute the workflow to completion on first use")
+	f.Type().Id(svc.names.testClient).Struct(
+		g.Id("newEnv").Func().Params().Op("*").Id(svc.names.testEnv),
+		g.Id("mu").Qual("sync", "Mutex"),
+		g.Id("runs").Map(g.String()).Op("*").Id(svc.names.testClientRun),
+	)
+}
+
+// genTestClientConstructor generates a NewTestClient public function
+func (svc *Service) genTestClientConstructor(f *g.File) {
+	f.Commentf("%s initializes a new %s that calls newEnv to create the %s used by each", svc.names.newTestClient, svc.names.testClient, svc.names.testEnv)
+	f.Comment("workflow execution")
+	f.Func().
+		Id(svc.names.newTestClient).
+		Params(g.Id("newEnv").Func().Params().Op("*").Id(svc.names.testEnv)).
+		Params(g.Op("*").Id(svc.names.testClient)).
+		Block(
+			g.Return(g.Op("&").Id(svc.names.testClient).Values(g.Dict{
+				g.Id("newEnv"): g.Id("newEnv"),
+				g.Id("runs"):   g.Map(g.String()).Op("*").Id(svc.names.testClientRun).Values(),
+			})),
+		)
+}
+
+// genTestClientRuns generates unexported TestClient methods for starting and looking up executions
+func (svc *Service) genTestClientRuns(f *g.File) {
+	f.Comment("start initializes a new workflow execution using a new test environment")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id("start").
+		Params(
+			g.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions"),
+			g.Id("workflow").String(),
+			g.Id("args").Op("...").Any(),
+		).
+		Params(g.Op("*").Id(svc.names.testClientRun), g.Error()).
+		Block(
+			g.Id("c").Dot("mu").Dot("Lock").Call(),
+			g.Defer().Id("c").Dot("mu").Dot("Unlock").Call(),
+			g.If(g.Id("opts").Dot("ID").Op("==").Lit("")).Block(
+				g.Id("opts").Dot("ID").Op("=").Qual(uuidPkg, "NewString").Call(),
+			),
+			g.If(
+				g.List(g.Id("run"), g.Id("ok")).Op(":=").Id("c").Dot("runs").Index(g.Id("opts").Dot("ID")),
+				g.Id("ok"),
+			).Block(
+				g.Id("run").Dot("mu").Dot("Lock").Call(),
+				g.Id("executed").Op(":=").Id("run").Dot("executed"),
+				g.Id("run").Dot("mu").Dot("Unlock").Call(),
+				g.If(g.Op("!").Id("executed")).Block(
+					g.Return(g.Nil(), g.Qual(serviceerrorPkg, "NewWorkflowExecutionAlreadyStarted").Call(
+						g.Qual("fmt", "Sprintf").Call(g.Lit("workflow %s already started"), g.Id("opts").Dot("ID")),
+						g.Lit(""),
+						g.Id("run").Dot("runID"),
+					)),
+				),
+			),
+			g.Id("env").Op(":=").Id("c").Dot("newEnv").Call(),
+			g.Id("env").Dot("SetStartWorkflowOptions").Call(g.Op("*").Id("opts")),
+			g.Id("run").Op(":=").Op("&").Id(svc.names.testClientRun).Values(g.Dict{
+				g.Id("env"):      g.Id("env"),
+				g.Id("id"):       g.Id("opts").Dot("ID"),
+				g.Id("runID"):    g.Qual(uuidPkg, "NewString").Call(),
+				g.Id("workflow"): g.Id("workflow"),
+				g.Id("args"):     g.Id("args"),
+			}),
+			g.Id("c").Dot("runs").Index(g.Id("opts").Dot("ID")).Op("=").Id("run"),
+			g.Return(g.Id("run"), g.Nil()),
+		)
+
+	f.Comment("run returns an existing workflow execution")
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id("run").
+		Params(g.Id("workflowID").String(), g.Id("runID").String()).
+		Params(g.Op("*").Id(svc.names.testClientRun), g.Error()).
+		Block(
+			g.Id("c").Dot("mu").Dot("Lock").Call(),
+			g.Defer().Id("c").Dot("mu").Dot("Unlock").Call(),
+			g.List(g.Id("run"), g.Id("ok")).Op(":=").Id("c").Dot("runs").Index(g.Id("workflowID")),
+			g.If(g.Op("!").Id("ok").Op("||").Parens(g.Id("runID").Op("!=").Lit("").Op("&&").Id("runID").Op("!=").Id("run").Dot("runID"))).Block(
+				g.Return(g.Nil(), g.Qual(serviceerrorPkg, "NewNotFound").Call(
+					g.Qual("fmt", "Sprintf").Call(g.Lit("workflow %s not found"), g.Id("workflowID")),
+				)),
+			),
+			g.Return(g.Id("run"), g.Nil()),
+		)
+}
+
+// genTestClientWorkflow generates a <Workflow> TestClient method
+func (svc *Service) genTestClientWorkflow(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	f.Commentf("%s executes a %s workflow in a new test environment and blocks until it completes", workflow, workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(workflow).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(method.Output))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("Execute%s", workflow)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				args.Id("opts")
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).BlockFunc(func(bl *g.Group) {
+				if hasOutput {
+					bl.Return(g.Nil(), g.Err())
+				} else {
+					bl.Return(g.Err())
+				}
+			})
+			fn.Return(g.Id("run").Dot("Get").Call(g.Id("ctx")))
+		})
+}
+
+// genTestClientWorkflowExecute generates an Execute<Workflow> TestClient method
+func (svc *Service) genTestClientWorkflowExecute(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Execute%s starts a %s workflow in a new test environment", workflow, workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Execute%s", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(g.Id(fmt.Sprintf("%sRun", workflow)), g.Error()).
+		BlockFunc(func(fn *g.Group) {
+			svc.genStartWorkflowOptions(fn, workflow, false)
+			fn.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot("start").CallFunc(func(args *g.Group) {
+				args.Id("opts")
+				args.Id(fmt.Sprintf("%sWorkflowName", workflow))
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Op("&").Id(svc.testClientWorkflowRun(workflow)).Values(g.Id("run")), g.Nil())
+		})
+}
+
+// genTestClientWorkflowGet generates a Get<Workflow> TestClient method
+func (svc *Service) genTestClientWorkflowGet(f *g.File, workflow string) {
+	f.Commentf("Get%s fetches an existing %s execution started by the test client", workflow, workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Get%s", workflow)).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("workflowID").String(),
+			g.Id("runID").String(),
+		).
+		Params(g.Id(fmt.Sprintf("%sRun", workflow)), g.Error()).
+		Block(
+			g.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot("run").Call(g.Id("workflowID"), g.Id("runID")),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			),
+			g.Return(g.Op("&").Id(svc.testClientWorkflowRun(workflow)).Values(g.Id("run")), g.Nil()),
+		)
+}
+
+// genTestClientSignalWithStart generates a Start<Workflow>With<Signal> TestClient method
+func (svc *Service) genTestClientSignalWithStart(f *g.File, workflow, signal string) {
+	method := svc.methods[workflow]
+	handler := svc.methods[signal]
+	hasWorkflowInput := !isEmpty(method.Input)
+	hasSignalInput := !isEmpty(handler.Input)
+
+	f.Commentf("Start%sWith%s starts a %s workflow and sends a %s signal that is delivered when it starts", workflow, signal, workflow, signal)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Start%sWith%s", workflow, signal)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if hasWorkflowInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+			if hasSignalInput {
+				args.Id("signal").Op("*").Add(messageType(handler.Input))
+			}
+		}).
+		Params(g.Id(fmt.Sprintf("%sRun", workflow)), g.Error()).
+		Block(
+			g.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("Execute%s", workflow)).CallFunc(func(args *g.Group) {
+				args.Id("ctx")
+				args.Id("opts")
+				if hasWorkflowInput {
+					args.Id("req")
+				}
+			}),
+			g.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			),
+			g.If(
+				g.Err().Op(":=").Id("run").Dot(signal).CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					if hasSignalInput {
+						args.Id("signal")
+					}
+				}),
+				g.Err().Op("!=").Nil(),
+			).Block(
+				g.Return(g.Nil(), g.Err()),
+			),
+			g.Return(g.Id("run"), g.Nil()),
+		)
+}
+
+// genTestClientSchedule generates Create<Workflow>Schedule and Get<Workflow>Schedule TestClient
+// methods that return an error, as schedules cannot be emulated by the test environment
+func (svc *Service) genTestClientSchedule(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	handle := g.Id(fmt.Sprintf("%sScheduleHandle", workflow))
+	err := g.Qual("errors", "New").Call(g.Lit(fmt.Sprintf("schedules are not supported by %s", svc.names.testClient)))
+
+	f.Commentf("Create%sSchedule returns an error, as schedules are not supported by the test client", workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Create%sSchedule", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("schedule").Op("*").Qual(clientPkg, "ScheduleOptions")
+			args.Id("opts").Op("*").Qual(clientPkg, "StartWorkflowOptions")
+			if !isEmpty(method.Input) {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(handle.Clone(), g.Error()).
+		Block(
+			g.Return(g.Nil(), err.Clone()),
+		)
+
+	f.Commentf("Get%sSchedule returns an error, as schedules are not supported by the test client", workflow)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Get%sSchedule", workflow)).
+		Params(
+			g.Id("ctx").Qual("context", "Context"),
+			g.Id("scheduleID").String(),
+		).
+		Params(handle.Clone(), g.Error()).
+		Block(
+			g.Return(g.Nil(), err.Clone()),
+		)
+}
+
+// genTestClientQuery generates a Query<Query> TestClient method
+func (svc *Service) genTestClientQuery(f *g.File, query string) {
+	method := svc.methods[query]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Query%s executes an existing workflow to completion and sends it a %s query", query, query)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Query%s", query)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			if hasInput {
+				args.Id("query").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(g.Op("*").Add(messageType(method.Output)), g.Error()).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot("run").Call(g.Id("workflowID"), g.Id("runID"))
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			svc.genTestClientQueryBody(fn, query, hasInput, "query")
+		})
+}
+
+// genTestClientSignal generates a Signal<Signal> TestClient method
+func (svc *Service) genTestClientSignal(f *g.File, signal string) {
+	method := svc.methods[signal]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Signal%s sends a %s signal to an existing workflow that has not yet been executed", signal, signal)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Signal%s", signal)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			if hasInput {
+				args.Id("signal").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Error().
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot("run").Call(g.Id("workflowID"), g.Id("runID"))
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Err()),
+			)
+			svc.genTestClientSignalBody(fn, signal, hasInput, "signal")
+		})
+}
+
+// genTestClientUpdate generates an Update<Update> TestClient method
+func (svc *Service) genTestClientUpdate(f *g.File, update string) {
+	method := svc.methods[update]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	f.Commentf("Update%s sends a %s update to an existing workflow and executes it to completion", update, update)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(svc.names.testClient)).
+		Id(fmt.Sprintf("Update%s", update)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual("context", "Context")
+			args.Id("workflowID").String()
+			args.Id("runID").String()
+			if hasInput {
+				args.Id("update").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(method.Output))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("run"), g.Err()).Op(":=").Id("c").Dot("run").Call(g.Id("workflowID"), g.Id("runID"))
+			fn.If(g.Err().Op("!=").Nil()).BlockFunc(func(bl *g.Group) {
+				if hasOutput {
+					bl.Return(g.Nil(), g.Err())
+				} else {
+					bl.Return(g.Err())
+				}
+			})
+			svc.genTestClientUpdateBody(fn, update, hasInput, hasOutput, "update")
+		})
+}
+
+// genTestClientQueryBody generates statements that send a query to the *testClientRun named run
+func (svc *Service) genTestClientQueryBody(fn *g.Group, query string, hasInput bool, arg string) {
+	method := svc.methods[query]
+	fn.Var().Id("resp").Add(messageType(method.Output))
+	fn.If(
+		g.Err().Op(":=").Id("run").Dot("query").CallFunc(func(args *g.Group) {
+			args.Id(fmt.Sprintf("%sQueryName", query))
+			args.Op("&").Id("resp")
+			if hasInput {
+				args.Id(arg)
+			}
+		}),
+		g.Err().Op("!=").Nil(),
+	).Block(
+		g.Return(g.Nil(), g.Err()),
+	)
+	fn.Return(g.Op("&").Id("resp"), g.Nil())
+}
+
+// genTestClientSignalBody generates statements that send a signal to the *testClientRun named run
+func (svc *Service) genTestClientSignalBody(fn *g.Group, signal string, hasInput bool, arg string) {
+	fn.Return(g.Id("run").Dot("signal").CallFunc(func(args *g.Group) {
+		args.Id(fmt.Sprintf("%sSignalName", signal))
+		if hasInput {
+			args.Id(arg)
+		} else {
+			args.Nil()
+		}
+	}))
+}
+
+// genTestClientUpdateBody generates statements that send an update to the *testClientRun named run
+func (svc *Service) genTestClientUpdateBody(fn *g.Group, update string, hasInput, hasOutput bool, arg string) {
+	method := svc.methods[update]
+	call := g.Id("run").Dot("update").CallFunc(func(args *g.Group) {
+		args.Id(fmt.Sprintf("%sUpdateName", update))
+		if hasInput {
+			args.Id(arg)
+		}
+	})
+	if !hasOutput {
+		fn.List(g.Id("_"), g.Err()).Op(":=").Add(call)
+		fn.Return(g.Err())
+		return
+	}
+	fn.List(g.Id("out"), g.Err()).Op(":=").Add(call)
+	fn.If(g.Err().Op("!=").Nil()).Block(
+		g.Return(g.Nil(), g.Err()),
+	)
+	fn.List(g.Id("resp"), g.Id("ok")).Op(":=").Id("out").Assert(g.Op("*").Add(messageType(method.Output)))
+	fn.If(g.Op("!").Id("ok")).Block(
+		g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit(fmt.Sprintf("unexpected %s update result type: %%T", update)), g.Id("out"))),
+	)
+	fn.Return(g.Id("resp"), g.Nil())
+}
+
+// genTestClientRun generates the testClientRun type shared by the <Workflow>Run implementations
+func (svc *Service) genTestClientRun(f *g.File) {
+	run := svc.names.testClientRun
+	lock := []g.Code{
+		g.Id("r").Dot("mu").Dot("Lock").Call(),
+		g.Defer().Id("r").Dot("mu").Dot("Unlock").Call(),
+	}
+
+	f.Commentf("%s describes a workflow execution started by a %s", run, svc.names.testClient)
+	f.Type().Id(run).Struct(
+		g.Id("mu").Qual("sync", "Mutex"),
+		g.Id("env").Op("*").Id(svc.names.testEnv),
+		g.Id("id").String(),
+		g.Id("runID").String(),
+		g.Id("workflow").String(),
+		g.Id("args").Index().Any(),
+		g.Id("executed").Bool(),
+	)
+
+	f.Comment("execute runs the workflow to completion if it has not already been executed")
+	f.Func().
+		Params(g.Id("r").Op("*").Id(run)).
+		Id("execute").
+		Params().
+		Block(
+			g.If(g.Op("!").Id("r").Dot("executed")).Block(
+				g.Id("r").Dot("executed").Op("=").True(),
+				g.Id("r").Dot("env").Dot("ExecuteWorkflow").Call(g.Id("r").Dot("workflow"), g.Id("r").Dot("args").Op("...")),
+			),
+		)
+
+	f.Comment("get executes the workflow and decodes its result into resp, if not nil")
+	f.Func().
+		Params(g.Id("r").Op("*").Id(run)).
+		Id("get").
+		Params(g.Id("resp").Any()).
+		Error().
+		Block(append(lock,
+			g.Id("r").Dot("execute").Call(),
+			g.If(g.Err().Op(":=").Id("r").Dot("env").Dot("GetWorkflowError").Call(), g.Err().Op("!=").Nil().Op("||").Id("resp").Op("==").Nil()).Block(
+				g.Return(g.Err()),
+			),
+			g.Return(g.Id("r").Dot("env").Dot("GetWorkflowResult").Call(g.Id("resp"))),
+		)...)
+
+	if len(svc.queriesOrdered) > 0 {
+		f.Comment("query executes the workflow and decodes the result of the named query into resp")
+		f.Func().
+			Params(g.Id("r").Op("*").Id(run)).
+			Id("query").
+			Params(g.Id("name").String(), g.Id("resp").Any(), g.Id("args").Op("...").Any()).
+			Error().
+			Block(append(lock,
+				g.Id("r").Dot("execute").Call(),
+				g.List(g.Id("val"), g.Err()).Op(":=").Id("r").Dot("env").Dot("QueryWorkflow").Call(g.Id("name"), g.Id("args").Op("...")),
+				g.If(g.Err().Op("!=").Nil()).Block(
+					g.Return(g.Err()),
+				),
+				g.Return(g.Id("val").Dot("Get").Call(g.Id("resp"))),
+			)...)
+	}
+
+	if len(svc.signalsOrdered) > 0 {
+		f.Comment("signal queues the named signal for delivery when the workflow starts")
+		f.Func().
+			Params(g.Id("r").Op("*").Id(run)).
+			Id("signal").
+			Params(g.Id("name").String(), g.Id("arg").Any()).
+			Error().
+			Block(append(lock,
+				g.If(g.Id("r").Dot("executed")).Block(
+					g.Return(g.Qual(serviceerrorPkg, "NewNotFound").Call(
+						g.Qual("fmt", "Sprintf").Call(g.Lit("workflow %s already completed"), g.Id("r").Dot("id")),
+					)),
+				),
+				g.Id("r").Dot("env").Dot("RegisterDelayedCallback").Call(
+					g.Func().Params().Block(
+						g.Id("r").Dot("env").Dot("SignalWorkflow").Call(g.Id("name"), g.Id("arg")),
+					),
+					g.Lit(0),
+				),
+				g.Return(g.Nil()),
+			)...)
+	}
+
+	if len(svc.updatesOrdered) > 0 {
+		f.Comment("update queues the named update for delivery once the workflow starts, executes the workflow,")
+		f.Comment("and returns the update result")
+		f.Func().
+			Params(g.Id("r").Op("*").Id(run)).
+			Id("update").
+			Params(g.Id("name").String(), g.Id("args").Op("...").Any()).
+			Params(g.Any(), g.Error()).
+			Block(append(lock,
+				g.If(g.Id("r").Dot("executed")).Block(
+					g.Return(g.Nil(), g.Qual(serviceerrorPkg, "NewNotFound").Call(
+						g.Qual("fmt", "Sprintf").Call(g.Lit("workflow %s already completed"), g.Id("r").Dot("id")),
+					)),
+				),
+				g.Id("uc").Op(":=").Op("&").Id(svc.names.testUpdateCallbacks).Values(),
+				g.Comment("callbacks registered before execution run before the workflow starts, so queue the"),
+				g.Comment("update behind the start of the workflow"),
+				g.Id("r").Dot("env").Dot("RegisterDelayedCallback").Call(
+					g.Func().Params().Block(
+						g.Id("r").Dot("env").Dot("RegisterDelayedCallback").Call(
+							g.Func().Params().Block(
+								g.Id("r").Dot("env").Dot("UpdateWorkflow").Call(g.Id("name"), g.Id("uc"), g.Id("args").Op("...")),
+							),
+							g.Lit(0),
+						),
+					),
+					g.Lit(0),
+				),
+				g.Id("r").Dot("execute").Call(),
+				g.If(g.Op("!").Id("uc").Dot("completed")).Block(
+					g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("%s update did not complete"), g.Id("name"))),
+				),
+				g.Return(g.Id("uc").Dot("result"), g.Id("uc").Dot("err")),
+			)...)
+
+		uc := svc.names.testUpdateCallbacks
+		f.Commentf("%s records the outcome of an update sent by a %s", uc, svc.names.testClient)
+		f.Type().Id(uc).Struct(
+			g.Id("completed").Bool(),
+			g.Id("result").Any(),
+			g.Id("err").Error(),
+		)
+
+		f.Comment("Accept is called when an update is accepted by the workflow")
+		f.Func().Params(g.Id("uc").Op("*").Id(uc)).Id("Accept").Params().Block()
+
+		f.Comment("Reject is called when an update is rejected by the workflow")
+		f.Func().Params(g.Id("uc").Op("*").Id(uc)).Id("Reject").Params(g.Err().Error()).Block(
+			g.Id("uc").Dot("completed").Op("=").True(),
+			g.Id("uc").Dot("err").Op("=").Err(),
+		)
+
+		f.Comment("Complete is called when an update handler returns")
+		f.Func().Params(g.Id("uc").Op("*").Id(uc)).Id("Complete").Params(g.Id("success").Any(), g.Err().Error()).Block(
+			g.Id("uc").Dot("completed").Op("=").True(),
+			g.Id("uc").Dot("result").Op("=").Id("success"),
+			g.Id("uc").Dot("err").Op("=").Err(),
+		)
+	}
+}
+
+// genTestClientWorkflowRun generates a <Workflow>Run implementation returned by TestClient
+func (svc *Service) genTestClientWorkflowRun(f *g.File, workflow string) {
+	opts := svc.workflows[workflow]
+	method := svc.methods[workflow]
+	hasOutput := !isEmpty(method.Output)
+	typeName := svc.testClientWorkflowRun(workflow)
+	runIface := fmt.Sprintf("%sRun", workflow)
+
+	f.Commentf("%s provides a %s implementation for executions started by a %s", typeName, runIface, svc.names.testClient)
+	f.Type().Id(typeName).Struct(
+		g.Id("run").Op("*").Id(svc.names.testClientRun),
+	)
+
+	f.Comment("ID returns the workflow ID")
+	f.Func().Params(g.Id("r").Op("*").Id(typeName)).Id("ID").Params().String().Block(
+		g.Return(g.Id("r").Dot("run").Dot("id")),
+	)
+
+	f.Comment("RunID returns the workflow run ID")
+	f.Func().Params(g.Id("r").Op("*").Id(typeName)).Id("RunID").Params().String().Block(
+		g.Return(g.Id("r").Dot("run").Dot("runID")),
+	)
+
+	f.Comment("Get executes the workflow to completion and returns its result")
+	f.Func().
+		Params(g.Id("r").Op("*").Id(typeName)).
+		Id("Get").
+		Params(g.Id("ctx").Qual("context", "Context")).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(method.Output))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			if !hasOutput {
+				fn.Return(g.Id("r").Dot("run").Dot("get").Call(g.Nil()))
+				return
+			}
+			fn.Var().Id("resp").Add(messageType(method.Output))
+			fn.If(g.Err().Op(":=").Id("r").Dot("run").Dot("get").Call(g.Op("&").Id("resp")), g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Op("&").Id("resp"), g.Nil())
+		})
+
+	for _, queryOpts := range opts.GetQuery() {
+		query := queryOpts.GetRef()
+		handler := svc.methods[query]
+		hasInput := !isEmpty(handler.Input)
+		f.Commentf("%s executes the workflow to completion and sends it a %s query", query, query)
+		f.Func().
+			Params(g.Id("r").Op("*").Id(typeName)).
+			Id(query).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(handler.Input))
+				}
+			}).
+			Params(g.Op("*").Add(messageType(handler.Output)), g.Error()).
+			BlockFunc(func(fn *g.Group) {
+				fn.Id("run").Op(":=").Id("r").Dot("run")
+				svc.genTestClientQueryBody(fn, query, hasInput, "req")
+			})
+	}
+
+	for _, signalOpts := range opts.GetSignal() {
+		signal := signalOpts.GetRef()
+		handler := svc.methods[signal]
+		hasInput := !isEmpty(handler.Input)
+		f.Commentf("%s sends a %s signal that is delivered when the workflow starts", signal, signal)
+		f.Func().
+			Params(g.Id("r").Op("*").Id(typeName)).
+			Id(signal).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(handler.Input))
+				}
+			}).
+			Error().
+			BlockFunc(func(fn *g.Group) {
+				fn.Id("run").Op(":=").Id("r").Dot("run")
+				svc.genTestClientSignalBody(fn, signal, hasInput, "req")
+			})
+	}
+
+	for _, updateOpts := range opts.GetUpdate() {
+		update := updateOpts.GetRef()
+		handler := svc.methods[update]
+		hasInput := !isEmpty(handler.Input)
+		hasOutput := !isEmpty(handler.Output)
+		f.Commentf("%s sends a %s update and executes the workflow to completion", update, update)
+		f.Func().
+			Params(g.Id("r").Op("*").Id(typeName)).
+			Id(update).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(handler.Input))
+				}
+			}).
+			ParamsFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Op("*").Add(messageType(handler.Output))
+				}
+				returnVals.Error()
+			}).
+			BlockFunc(func(fn *g.Group) {
+				fn.Id("run").Op(":=").Id("r").Dot("run")
+				svc.genTestClientUpdateBody(fn, update, hasInput, hasOutput, "req")
+			})
+	}
+}
+
+// testClientWorkflowRun returns the name of the <Workflow>Run implementation returned by TestClient
+func (svc *Service) testClientWorkflowRun(workflow string) string {
+	return fmt.Sprintf("test%sRun", workflow)
+}
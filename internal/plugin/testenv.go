@@ -0,0 +1,274 @@
+package plugin
+
+import (
+	"fmt"
+
+	g "github.com/dave/jennifer/jen"
+)
+
+// renderTestEnv writes typed testsuite.TestWorkflowEnvironment helpers for the service
+func (svc *Service) renderTestEnv(f *g.File) {
+	svc.genTestEnv(f)
+	svc.genTestEnvConstructor(f)
+	for _, workflow := range svc.workflowsOrdered {
+		svc.genTestEnvExecuteWorkflow(f, workflow)
+	}
+	for _, query := range svc.queriesOrdered {
+		svc.genTestEnvQuery(f, query)
+	}
+	for _, signal := range svc.signalsOrdered {
+		svc.genTestEnvSignalAfter(f, signal)
+	}
+	if !svc.cfg.DisableActivities {
+		for _, activity := range svc.activitiesOrdered {
+			svc.genTestEnvOnActivity(f, activity)
+		}
+		svc.genTestEnvActivities(f)
+	}
+	if !svc.cfg.DisableClient {
+		svc.renderTestClient(f)
+	}
+}
+
+// genTestEnv generates a TestEnv struct
+func (svc *Service) genTestEnv(f *g.File) {
+	f.Commentf("%s wraps a testsuite.TestWorkflowEnvironment with typed helpers for %s workflows", svc.names.testEnv, svc.GoName)
+	f.Type().Id(svc.names.testEnv).Struct(
+		g.Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment"),
+	)
+}
+
+// genTestEnvConstructor generates a NewTestEnv public function
+func (svc *Service) genTestEnvConstructor(f *g.File) {
+	f.Commentf("%s initializes a new %s that registers the given workflows with the test environment.", svc.names.newTestEnv, svc.names.testEnv)
+	if !svc.cfg.DisableActivities {
+		f.Comment("When activities is nil, placeholder activities are registered that must be mocked using the")
+		f.Comment("On<Activity> helpers")
+	}
+	f.Func().
+		Id(svc.names.newTestEnv).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("env").Op("*").Qual(testsuitePkg, "TestWorkflowEnvironment")
+			args.Id("workflows").Id(svc.names.workflows)
+			if !svc.cfg.DisableActivities {
+				args.Id("activities").Id(svc.names.activities)
+			}
+		}).
+		Params(g.Op("*").Id(svc.names.testEnv)).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id(svc.names.registerWorkflows).Call(g.Id("env"), g.Id("workflows"))
+			if !svc.cfg.DisableActivities {
+				fn.If(g.Id("activities").Op("==").Nil()).Block(
+					g.Id("activities").Op("=").Op("&").Id(svc.names.testEnvActivities).Block(),
+				)
+				fn.Id(svc.names.registerActivities).Call(g.Id("env"), g.Id("activities"))
+			}
+			fn.Return(g.Op("&").Id(svc.names.testEnv).Values(g.Id("env")))
+		})
+}
+
+// genTestEnvExecuteWorkflow generates an Execute<Workflow> method
+func (svc *Service) genTestEnvExecuteWorkflow(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+
+	f.Commentf("Execute%s executes a %s workflow in the test environment and blocks until it completes", workflow, workflow)
+	f.Func().
+		Params(g.Id("e").Op("*").Id(svc.names.testEnv)).
+		Id(fmt.Sprintf("Execute%s", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		ParamsFunc(func(returnVals *g.Group) {
+			if hasOutput {
+				returnVals.Op("*").Add(messageType(method.Output))
+			}
+			returnVals.Error()
+		}).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("e").Dot("ExecuteWorkflow").CallFunc(func(args *g.Group) {
+				args.Id(fmt.Sprintf("%sWorkflowName", workflow))
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			if !hasOutput {
+				fn.Return(g.Id("e").Dot("GetWorkflowError").Call())
+				return
+			}
+			fn.If(g.Err().Op(":=").Id("e").Dot("GetWorkflowError").Call(), g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Var().Id("resp").Add(messageType(method.Output))
+			fn.If(g.Err().Op(":=").Id("e").Dot("GetWorkflowResult").Call(g.Op("&").Id("resp")), g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Op("&").Id("resp"), g.Nil())
+		})
+}
+
+// genTestEnvQuery generates a Query<Query> method
+func (svc *Service) genTestEnvQuery(f *g.File, query string) {
+	method := svc.methods[query]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Query%s sends a %s query to the workflow executing in the test environment", query, query)
+	f.Func().
+		Params(g.Id("e").Op("*").Id(svc.names.testEnv)).
+		Id(fmt.Sprintf("Query%s", query)).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Params(
+			g.Op("*").Add(messageType(method.Output)),
+			g.Error(),
+		).
+		BlockFunc(func(fn *g.Group) {
+			fn.List(g.Id("val"), g.Err()).Op(":=").Id("e").Dot("QueryWorkflow").CallFunc(func(args *g.Group) {
+				args.Id(fmt.Sprintf("%sQueryName", query))
+				if hasInput {
+					args.Id("req")
+				}
+			})
+			fn.If(g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Var().Id("resp").Add(messageType(method.Output))
+			fn.If(g.Err().Op(":=").Id("val").Dot("Get").Call(g.Op("&").Id("resp")), g.Err().Op("!=").Nil()).Block(
+				g.Return(g.Nil(), g.Err()),
+			)
+			fn.Return(g.Op("&").Id("resp"), g.Nil())
+		})
+}
+
+// genTestEnvSignalAfter generates a Signal<Signal>After method
+func (svc *Service) genTestEnvSignalAfter(f *g.File, signal string) {
+	method := svc.methods[signal]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("Signal%sAfter sends a %s signal to the workflow executing in the test environment", signal, signal)
+	f.Comment("after the given delay")
+	f.Func().
+		Params(g.Id("e").Op("*").Id(svc.names.testEnv)).
+		Id(fmt.Sprintf("Signal%sAfter", signal)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("d").Qual("time", "Duration")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Block(
+			g.Id("e").Dot("RegisterDelayedCallback").Call(
+				g.Func().Params().Block(
+					g.Id("e").Dot("SignalWorkflow").CallFunc(func(args *g.Group) {
+						args.Id(fmt.Sprintf("%sSignalName", signal))
+						if hasInput {
+							args.Id("req")
+						} else {
+							args.Nil()
+						}
+					}),
+				),
+				g.Id("d"),
+			),
+		)
+}
+
+// genTestEnvOnActivity generates an On<Activity> method and typed mock call
+func (svc *Service) genTestEnvOnActivity(f *g.File, activity string) {
+	method := svc.methods[activity]
+	hasInput := !isEmpty(method.Input)
+	hasOutput := !isEmpty(method.Output)
+	callName := fmt.Sprintf("%s%sCall", svc.names.testEnv, activity)
+
+	f.Commentf("On%s mocks a %s activity execution in the test environment", activity, activity)
+	f.Func().
+		Params(g.Id("e").Op("*").Id(svc.names.testEnv)).
+		Id(fmt.Sprintf("On%s", activity)).
+		ParamsFunc(func(args *g.Group) {
+			if hasInput {
+				args.Id("req").Any()
+			}
+		}).
+		Params(g.Op("*").Id(callName)).
+		Block(
+			g.Return(g.Op("&").Id(callName).Values(
+				g.Id("e").Dot("OnActivity").CallFunc(func(args *g.Group) {
+					args.Id(fmt.Sprintf("%sActivityName", activity))
+					args.Qual(mockPkg, "Anything")
+					if hasInput {
+						args.Id("req")
+					}
+				}),
+			)),
+		)
+
+	f.Commentf("%s wraps a %s activity mock with typed return values", callName, activity)
+	f.Type().Id(callName).Struct(
+		g.Op("*").Qual(testsuitePkg, "MockCallWrapper"),
+	)
+
+	f.Commentf("Return sets the values returned by the mocked %s activity", activity)
+	f.Func().
+		Params(g.Id("c").Op("*").Id(callName)).
+		Id("Return").
+		ParamsFunc(func(args *g.Group) {
+			if hasOutput {
+				args.Id("resp").Op("*").Add(messageType(method.Output))
+			}
+			args.Err().Error()
+		}).
+		Params(g.Op("*").Id(callName)).
+		Block(
+			g.Id("c").Dot("MockCallWrapper").Dot("Return").CallFunc(func(args *g.Group) {
+				if hasOutput {
+					args.Id("resp")
+				}
+				args.Err()
+			}),
+			g.Return(g.Id("c")),
+		)
+}
+
+// genTestEnvActivities generates placeholder activities registered when a TestEnv is
+// initialized without an activities implementation
+func (svc *Service) genTestEnvActivities(f *g.File) {
+	f.Commentf("%s provides placeholder activities that must be mocked", svc.names.testEnvActivities)
+	f.Type().Id(svc.names.testEnvActivities).Struct()
+
+	for _, activity := range svc.activitiesOrdered {
+		method := svc.methods[activity]
+		hasInput := !isEmpty(method.Input)
+		hasOutput := !isEmpty(method.Output)
+
+		f.Commentf("%s returns an error indicating that the activity was not mocked", activity)
+		f.Func().
+			Params(g.Id("a").Op("*").Id(svc.names.testEnvActivities)).
+			Id(activity).
+			ParamsFunc(func(args *g.Group) {
+				args.Id("ctx").Qual("context", "Context")
+				if hasInput {
+					args.Id("req").Op("*").Add(messageType(method.Input))
+				}
+			}).
+			ParamsFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Op("*").Add(messageType(method.Output))
+				}
+				returnVals.Error()
+			}).
+			BlockFunc(func(fn *g.Group) {
+				err := g.Qual("fmt", "Errorf").Call(g.Lit("%s activity is not mocked"), g.Id(fmt.Sprintf("%sActivityName", activity)))
+				if hasOutput {
+					fn.Return(g.Nil(), err)
+				} else {
+					fn.Return(err)
+				}
+			})
+	}
+}
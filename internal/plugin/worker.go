@@ -2,7 +2,9 @@ package plugin
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode"
 
 	g "github.com/dave/jennifer/jen"
 	pgs "github.com/lyft/protoc-gen-star/v2"
@@ -11,8 +13,8 @@ import (
 // genWorkflowsInterface generates a Workflows interface for a given service
 func (svc *Service) genWorkflowsInterface(f *g.File) {
 	// generate workflows interface
-	f.Commentf("Workflows provides methods for initializing new %s workflow values", svc.GoName)
-	f.Type().Id("Workflows").InterfaceFunc(func(methods *g.Group) {
+	f.Commentf("%s provides methods for initializing new %s workflow values", svc.names.workflows, svc.GoName)
+	f.Type().Id(svc.names.workflows).InterfaceFunc(func(methods *g.Group) {
 		for _, workflow := range svc.workflowsOrdered {
 			// method := svc.methods[workflow]
 			methods.Commentf("%s initializes a new %sWorkflow value", workflow, workflow).Line().
@@ -32,12 +34,12 @@ func (svc *Service) genWorkflowsInterface(f *g.File) {
 // genRegisterWorkflows generates a public RegisterWorkflows method for a given service
 func (svc *Service) genRegisterWorkflows(f *g.File) {
 	// generate workflow registration function for service
-	f.Commentf("RegisterWorkflows registers %s workflows with the given worker", svc.GoName)
+	f.Commentf("%s registers %s workflows with the given worker", svc.names.registerWorkflows, svc.GoName)
 	f.Func().
-		Id("RegisterWorkflows").
+		Id(svc.names.registerWorkflows).
 		Params(
 			g.Id("r").Qual(workerPkg, "Registry"),
-			g.Id("workflows").Id("Workflows"),
+			g.Id("workflows").Id(svc.names.workflows),
 		).
 		BlockFunc(func(fn *g.Group) {
 			for _, workflow := range svc.workflowsOrdered {
@@ -48,6 +50,50 @@ func (svc *Service) genRegisterWorkflows(f *g.File) {
 		})
 }
 
+// genReplayer generates a New<Service>Replayer constructor and Replay<Service>Histories helper
+func (svc *Service) genReplayer(f *g.File) {
+	newReplayer := fmt.Sprintf("New%sReplayer", svc.GoName)
+
+	f.Commentf("%s initializes a new workflow replayer with all %s workflows registered", newReplayer, svc.GoName)
+	f.Func().
+		Id(newReplayer).
+		Params(
+			g.Id("workflows").Id(svc.names.workflows),
+		).
+		Params(
+			g.Qual(workerPkg, "WorkflowReplayer"),
+		).
+		BlockFunc(func(fn *g.Group) {
+			fn.Id("r").Op(":=").Qual(workerPkg, "NewWorkflowReplayer").Call()
+			for _, workflow := range svc.workflowsOrdered {
+				fn.Id("r").Dot("RegisterWorkflowWithOptions").Call(
+					g.Id(fmt.Sprintf("build%s", workflow)).Call(g.Id("workflows").Dot(workflow)),
+					g.Qual(workflowPkg, "RegisterOptions").Values(
+						g.Id("Name").Op(":").Id(fmt.Sprintf("%sWorkflowName", workflow)),
+					),
+				)
+			}
+			fn.Return(g.Id("r"))
+		})
+
+	f.Commentf("Replay%sHistories replays every JSON workflow history in the given directory against the", svc.GoName)
+	f.Commentf("given %s workflows, returning an error if any history fails to replay", svc.GoName)
+	f.Func().
+		Id(fmt.Sprintf("Replay%sHistories", svc.GoName)).
+		Params(
+			g.Id("workflows").Id(svc.names.workflows),
+			g.Id("dir").String(),
+		).
+		Error().
+		Block(
+			g.Return(g.Qual(replayutilPkg, "ReplayDir").Call(
+				g.Id(newReplayer).Call(g.Id("workflows")),
+				g.Nil(),
+				g.Id("dir"),
+			)),
+		)
+}
+
 // genWorkflowWorker generates a <Workflow>Worker struct
 func (svc *Service) genWorkflowWorker(f *g.File, workflow string) {
 	method := svc.methods[workflow]
@@ -78,6 +124,7 @@ func (svc *Service) genWorkflowWorkerExecuteMethod(f *g.File, workflow string) {
 	hasOutput := !isEmpty(method.Output)
 	privateName := pgs.Name(method.GoName).LowerCamelCase().String()
 	workerName := privateName
+	carrySignals := svc.carriesSignals(workflow)
 
 	// generate <Workflow> method for worker struct
 	f.Commentf("%s constructs a new %s value and executes it", method.GoName, method.GoName)
@@ -87,12 +134,15 @@ func (svc *Service) genWorkflowWorkerExecuteMethod(f *g.File, workflow string) {
 		ParamsFunc(func(args *g.Group) {
 			args.Id("ctx").Qual(workflowPkg, "Context")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+			if carrySignals {
+				args.Id("carried").Map(g.String()).Index().Op("*").Qual(anyPkg, "Any")
 			}
 		}).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Output))
 			}
 			returnVals.Error()
 		}).
@@ -112,6 +162,26 @@ func (svc *Service) genWorkflowWorkerExecuteMethod(f *g.File, workflow string) {
 				}
 			})
 
+			// restore signals carried over from a previous run
+			if carrySignals {
+				for _, s := range opts.GetSignal() {
+					signal := s.GetRef()
+					fn.If(
+						g.Err().Op(":=").Id("input").Dot(signal).Dot("restore").Call(
+							g.Id("ctx"), g.Id("carried").Index(g.Id(fmt.Sprintf("%sSignalName", signal))),
+						),
+						g.Err().Op("!=").Nil(),
+					).Block(
+						g.ReturnFunc(func(returnVals *g.Group) {
+							if hasOutput {
+								returnVals.Nil()
+							}
+							returnVals.Err()
+						}),
+					)
+				}
+			}
+
 			// call constructor to get workflow implementation
 			fn.List(g.Id("wf"), g.Err()).Op(":=").Id("w").Dot("ctor").Call(
 				g.Id("ctx"), g.Id("input"),
@@ -143,10 +213,90 @@ func (svc *Service) genWorkflowWorkerExecuteMethod(f *g.File, workflow string) {
 				)
 			}
 
+			// register update handlers
+			for _, u := range opts.GetUpdate() {
+				update := u.GetRef()
+				fn.If(
+					g.Err().Op(":=").Qual(workflowPkg, "SetUpdateHandlerWithOptions").Call(
+						g.Id("ctx"),
+						g.Id(fmt.Sprintf("%sUpdateName", update)),
+						g.Id("wf").Dot(update),
+						g.Qual(workflowPkg, "UpdateHandlerOptions").ValuesFunc(func(fields *g.Group) {
+							if svc.updates[update].GetValidate() {
+								fields.Id("Validator").Op(":").Id("wf").Dot(fmt.Sprintf("Validate%s", update))
+							}
+						}),
+					),
+					g.Err().Op("!=").Nil(),
+				).Block(
+					g.ReturnFunc(func(returnVals *g.Group) {
+						if hasOutput {
+							returnVals.Nil()
+						}
+						returnVals.Err()
+					}),
+				)
+			}
+
 			// execute workflow
-			fn.Return(
-				g.Id("wf").Dot("Execute").Call(g.Id("ctx")),
+			maxHistoryEvents := opts.GetContinueAsNew().GetMaxHistoryEvents()
+			if maxHistoryEvents <= 0 {
+				fn.Return(
+					g.Id("wf").Dot("Execute").Call(g.Id("ctx")),
+				)
+				return
+			}
+			if hasOutput {
+				fn.List(g.Id("resp"), g.Err()).Op(":=").Id("wf").Dot("Execute").Call(g.Id("ctx"))
+			} else {
+				fn.Err().Op("=").Id("wf").Dot("Execute").Call(g.Id("ctx"))
+			}
+			fn.If(
+				g.Op("!").Qual("errors", "Is").Call(g.Err(), g.Id(continueAsNewErr(workflow))),
+			).Block(
+				g.ReturnFunc(func(returnVals *g.Group) {
+					if hasOutput {
+						returnVals.Id("resp")
+					}
+					returnVals.Err()
+				}),
 			)
+
+			// continue as new when requested by the implementation, carrying over pending signals
+			if carrySignals {
+				fn.Id("carried").Op("=").Make(g.Map(g.String()).Index().Op("*").Qual(anyPkg, "Any"))
+				for _, s := range opts.GetSignal() {
+					signal := s.GetRef()
+					fn.If(
+						g.List(g.Id("carried").Index(g.Id(fmt.Sprintf("%sSignalName", signal))), g.Err()).Op("=").
+							Id("input").Dot(signal).Dot("drain").Call(g.Id("ctx")),
+						g.Err().Op("!=").Nil(),
+					).Block(
+						g.ReturnFunc(func(returnVals *g.Group) {
+							if hasOutput {
+								returnVals.Nil()
+							}
+							returnVals.Err()
+						}),
+					)
+				}
+			}
+			svc.genContinueAsNewOptions(fn, workflow)
+			fn.ReturnFunc(func(returnVals *g.Group) {
+				if hasOutput {
+					returnVals.Nil()
+				}
+				returnVals.Qual(workflowPkg, "NewContinueAsNewError").CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					args.Id(fmt.Sprintf("%sWorkflowName", workflow))
+					if hasInput {
+						args.Id("input").Dot("Req")
+					}
+					if carrySignals {
+						args.Id("carried")
+					}
+				})
+			})
 		})
 }
 
@@ -159,6 +309,7 @@ func (svc *Service) genWorkflowWorkerBuilderFunction(f *g.File, workflow string)
 	privateName := pgs.Name(method.GoName).LowerCamelCase().String()
 	workerName := privateName
 	builderName := fmt.Sprintf("build%s", method.GoName)
+	carrySignals := svc.carriesSignals(workflow)
 
 	// generate Build<Workflow> function
 	f.Commentf("%s converts a %s workflow struct into a valid workflow function", builderName, method.GoName)
@@ -181,12 +332,15 @@ func (svc *Service) genWorkflowWorkerBuilderFunction(f *g.File, workflow string)
 				ParamsFunc(func(args *g.Group) {
 					args.Qual(workflowPkg, "Context")
 					if hasInput {
-						args.Op("*").Id(method.Input.GoIdent.GoName)
+						args.Op("*").Add(messageType(method.Input))
+					}
+					if carrySignals {
+						args.Map(g.String()).Index().Op("*").Qual(anyPkg, "Any")
 					}
 				}).
 				ParamsFunc(func(returnVals *g.Group) {
 					if hasOutput {
-						returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+						returnVals.Op("*").Add(messageType(method.Output))
 					}
 					returnVals.Error()
 				}),
@@ -250,7 +404,7 @@ func (svc *Service) genWorkflowInterface(f *g.File, workflow string) {
 			).
 			ParamsFunc(func(returnVals *g.Group) {
 				if hasOutput {
-					returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+					returnVals.Op("*").Add(messageType(method.Output))
 				}
 				returnVals.Error()
 			})
@@ -264,14 +418,48 @@ func (svc *Service) genWorkflowInterface(f *g.File, workflow string) {
 			methods.Id(query).
 				ParamsFunc(func(args *g.Group) {
 					if hasInput {
-						args.Op("*").Id(handler.Input.GoIdent.GoName)
+						args.Op("*").Add(messageType(handler.Input))
 					}
 				}).
 				Params(
-					g.Op("*").Id(handler.Output.GoIdent.GoName),
+					g.Op("*").Add(messageType(handler.Output)),
 					g.Error(),
 				)
 		}
+
+		// add workflow update methods
+		for _, updateOpts := range opts.GetUpdate() {
+			update := updateOpts.GetRef()
+			handler := svc.methods[update]
+			hasInput := !isEmpty(handler.Input)
+			hasOutput := !isEmpty(handler.Output)
+			methods.Commentf("%s update handler", update)
+			methods.Id(update).
+				ParamsFunc(func(args *g.Group) {
+					args.Qual(workflowPkg, "Context")
+					if hasInput {
+						args.Op("*").Add(messageType(handler.Input))
+					}
+				}).
+				ParamsFunc(func(returnVals *g.Group) {
+					if hasOutput {
+						returnVals.Op("*").Add(messageType(handler.Output))
+					}
+					returnVals.Error()
+				})
+
+			if svc.updates[update].GetValidate() {
+				methods.Commentf("Validate%s validates a %s update before it is accepted", update, update)
+				methods.Id(fmt.Sprintf("Validate%s", update)).
+					ParamsFunc(func(args *g.Group) {
+						args.Qual(workflowPkg, "Context")
+						if hasInput {
+							args.Op("*").Add(messageType(handler.Input))
+						}
+					}).
+					Params(g.Error())
+			}
+		}
 	})
 }
 
@@ -283,7 +471,7 @@ func (svc *Service) genWorkflowInput(f *g.File, workflow string) {
 	f.Commentf("%sInput describes the input to a %s workflow constructor", workflow, workflow)
 	f.Type().Id(fmt.Sprintf("%sInput", workflow)).StructFunc(func(fields *g.Group) {
 		if hasInput {
-			fields.Id("Req").Op("*").Id(method.Input.GoIdent.GoName)
+			fields.Id("Req").Op("*").Add(messageType(method.Input))
 		}
 
 		// add workflow signals
@@ -292,6 +480,49 @@ func (svc *Service) genWorkflowInput(f *g.File, workflow string) {
 			fields.Id(signal).Op("*").Id(fmt.Sprintf("%sSignal", signal))
 		}
 	})
+
+	maxHistoryEvents := opts.GetContinueAsNew().GetMaxHistoryEvents()
+	if maxHistoryEvents <= 0 {
+		return
+	}
+	input := fmt.Sprintf("%sInput", workflow)
+
+	f.Commentf("%s is returned by %s.ContinueAsNew to request that the workflow continue as new", continueAsNewErr(workflow), input)
+	f.Var().Id(continueAsNewErr(workflow)).Op("=").Qual("errors", "New").Call(g.Lit("continue as new"))
+
+	f.Commentf("ShouldContinueAsNew returns true once the %s workflow history reaches %d events", workflow, maxHistoryEvents)
+	f.Func().
+		Params(g.Id("i").Op("*").Id(input)).
+		Id("ShouldContinueAsNew").
+		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
+		Bool().
+		Block(
+			g.Return(g.Qual(workflowPkg, "GetInfo").Call(g.Id("ctx")).Dot("GetCurrentHistoryLength").Call().Op(">=").Lit(int(maxHistoryEvents))),
+		)
+
+	f.Comment("ContinueAsNew returns an error that, when returned by Execute, continues the workflow as new")
+	switch {
+	case hasInput && svc.carriesSignals(workflow):
+		f.Comment("with the current Req, carrying over any pending signals")
+	case hasInput:
+		f.Comment("with the current Req")
+	case svc.carriesSignals(workflow):
+		f.Comment("carrying over any pending signals")
+	}
+	f.Func().
+		Params(g.Id("i").Op("*").Id(input)).
+		Id("ContinueAsNew").
+		Params().
+		Error().
+		Block(
+			g.Return(g.Id(continueAsNewErr(workflow))),
+		)
+}
+
+// continueAsNewErr returns the name of the sentinel error used to request that the given
+// workflow continue as new
+func continueAsNewErr(workflow string) string {
+	return fmt.Sprintf("err%sContinueAsNew", workflow)
 }
 
 // genExecuteChildWorkflow generates a public <Workflow>Child function
@@ -305,7 +536,7 @@ func (svc *Service) genExecuteChildWorkflow(f *g.File, workflow string) {
 			args.Id("ctx").Qual(workflowPkg, "Context")
 			args.Id("opts").Op("*").Qual(workflowPkg, "ChildWorkflowOptions")
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Op("*").Id(fmt.Sprintf("%sChildRun", workflow)).
@@ -330,6 +561,198 @@ func (svc *Service) genExecuteChildWorkflow(f *g.File, workflow string) {
 		})
 }
 
+// childIDSideEffectChangeID identifies the workflow version that evaluates child workflow id
+// expressions within a side effect
+const childIDSideEffectChangeID = "protoc-gen-go-temporal/child-id-side-effect"
+
+// genChildWorkflowID adds logic for evaluating a child workflow's id expression. Executions started
+// by earlier plugin versions evaluate the expression directly, while newer executions evaluate it
+// within a side effect. Evaluation errors are returned via a failed <Workflow>ChildRun future
+func (svc *Service) genChildWorkflowID(fn *g.Group, workflow string) {
+	hasInput := !isEmpty(svc.methods[workflow].Input)
+	expr := fmt.Sprintf("%sIDExpression", workflow)
+	failed := func(bl *g.Group, err g.Code) {
+		bl.Return(g.Op("&").Id(fmt.Sprintf("%sChildRun", workflow)).Values(
+			g.Id("Future").Op(":").Id(svc.names.newFailedChildFuture).Call(g.Id("ctx"), err),
+		))
+	}
+	fn.If(g.Id("opts").Dot("WorkflowID").Op("==").Lit("")).Block(
+		g.If(
+			g.Qual(workflowPkg, "GetVersion").Call(
+				g.Id("ctx"),
+				g.Lit(childIDSideEffectChangeID),
+				g.Qual(workflowPkg, "DefaultVersion"),
+				g.Lit(1),
+			).Op("==").Qual(workflowPkg, "DefaultVersion"),
+		).BlockFunc(func(bl *g.Group) {
+			bl.List(g.Id("id"), g.Err()).Op(":=").Qual(expressionPkg, "EvalExpression").CallFunc(func(args *g.Group) {
+				args.Id(expr)
+				if hasInput {
+					args.Id("req").Dot("ProtoReflect").Call()
+				} else {
+					args.Nil()
+				}
+			})
+			bl.If(g.Err().Op("!=").Nil()).BlockFunc(func(bl *g.Group) {
+				failed(bl, g.Err())
+			})
+			bl.Id("opts").Dot("WorkflowID").Op("=").Id("id")
+		}).Else().BlockFunc(func(bl *g.Group) {
+			svc.genSideEffectID(bl, workflow, "WorkflowID", expr, failed)
+		}),
+	)
+}
+
+// genSideEffectID adds logic for evaluating an id expression against the method input within a side
+// effect, as expressions may be non-deterministic (e.g. uuid_v4()), and assigning the result to the
+// given options field. Evaluation errors are passed to failed
+func (svc *Service) genSideEffectID(fn *g.Group, name, field, expr string, failed func(bl *g.Group, err g.Code)) {
+	hasInput := !isEmpty(svc.methods[name].Input)
+	fn.Var().Id("id").Struct(g.List(g.Id("Value"), g.Id("Err")).String())
+	fn.If(
+		g.Err().Op(":=").Qual(workflowPkg, "SideEffect").Call(
+			g.Id("ctx"),
+			g.Func().Params(g.Qual(workflowPkg, "Context")).Interface().Block(
+				g.List(g.Id("value"), g.Err()).Op(":=").Qual(expressionPkg, "EvalExpression").CallFunc(func(args *g.Group) {
+					args.Id(expr)
+					if hasInput {
+						args.Id("req").Dot("ProtoReflect").Call()
+					} else {
+						args.Nil()
+					}
+				}),
+				g.If(g.Err().Op("!=").Nil()).Block(
+					g.Id("id").Dot("Err").Op("=").Err().Dot("Error").Call(),
+				),
+				g.Id("id").Dot("Value").Op("=").Id("value"),
+				g.Return(g.Id("id")),
+			),
+		).Dot("Get").Call(g.Op("&").Id("id")),
+		g.Err().Op("!=").Nil(),
+	).BlockFunc(func(bl *g.Group) {
+		failed(bl, g.Err())
+	})
+	fn.If(g.Id("id").Dot("Err").Op("!=").Lit("")).BlockFunc(func(bl *g.Group) {
+		failed(bl, g.Qual("errors", "New").Call(g.Id("id").Dot("Err")))
+	})
+	fn.Id("opts").Dot(field).Op("=").Id("id").Dot("Value")
+}
+
+// genFailedChildFuture generates a workflow.ChildWorkflowFuture implementation that has already
+// failed, used when child workflow options cannot be initialized
+func (svc *Service) genFailedChildFuture(f *g.File) {
+	name := svc.names.failedChildFuture
+	f.Commentf("%s describes a child workflow future that failed before the child workflow was started", name)
+	f.Type().Id(name).Struct(
+		g.Qual(workflowPkg, "Future"),
+	)
+
+	f.Commentf("%s returns a child workflow future that has already failed with the given error", svc.names.newFailedChildFuture)
+	f.Func().Id(svc.names.newFailedChildFuture).
+		Params(g.Id("ctx").Qual(workflowPkg, "Context"), g.Err().Error()).
+		Op("*").Id(name).
+		Block(
+			g.List(g.Id("future"), g.Id("settable")).Op(":=").Qual(workflowPkg, "NewFuture").Call(g.Id("ctx")),
+			g.Id("settable").Dot("SetError").Call(g.Err()),
+			g.Return(g.Op("&").Id(name).Values(g.Id("Future").Op(":").Id("future"))),
+		)
+
+	f.Comment("GetChildWorkflowExecution returns the failed future")
+	f.Func().Params(g.Id("f").Op("*").Id(name)).Id("GetChildWorkflowExecution").
+		Params().
+		Qual(workflowPkg, "Future").
+		Block(g.Return(g.Id("f").Dot("Future")))
+
+	f.Comment("SignalChildWorkflow returns the failed future")
+	f.Func().Params(g.Id("f").Op("*").Id(name)).Id("SignalChildWorkflow").
+		Params(g.Qual(workflowPkg, "Context"), g.String(), g.Interface()).
+		Qual(workflowPkg, "Future").
+		Block(g.Return(g.Id("f").Dot("Future")))
+}
+
+// hasChildIDExpression returns true if any workflow defines an id expression evaluated by the
+// generated child workflow helpers
+func (svc *Service) hasChildIDExpression() bool {
+	for _, workflow := range svc.workflowsOrdered {
+		if svc.workflows[workflow].GetDefaultOptions().GetId() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// genWorkflowContinueAsNew generates a public <Workflow>ContinueAsNew function
+func (svc *Service) genWorkflowContinueAsNew(f *g.File, workflow string) {
+	method := svc.methods[workflow]
+	hasInput := !isEmpty(method.Input)
+	f.Commentf("%sContinueAsNew returns an error that instructs the current workflow to continue as a new %s workflow,", workflow, workflow)
+	f.Comment("applying the default task queue and timeouts")
+	f.Func().
+		Id(fmt.Sprintf("%sContinueAsNew", workflow)).
+		ParamsFunc(func(args *g.Group) {
+			args.Id("ctx").Qual(workflowPkg, "Context")
+			if hasInput {
+				args.Id("req").Op("*").Add(messageType(method.Input))
+			}
+		}).
+		Error().
+		BlockFunc(func(fn *g.Group) {
+			svc.genContinueAsNewOptions(fn, workflow)
+			fn.Return(
+				g.Qual(workflowPkg, "NewContinueAsNewError").CallFunc(func(args *g.Group) {
+					args.Id("ctx")
+					args.Id(fmt.Sprintf("%sWorkflowName", workflow))
+					if hasInput {
+						args.Id("req")
+					}
+				}),
+			)
+		})
+}
+
+// genContinueAsNewOptions adds logic for applying default task queue and timeouts to a
+// workflow context prior to continuing as new
+func (svc *Service) genContinueAsNewOptions(fn *g.Group, workflow string) {
+	opts := svc.workflowDefaultOptions(workflow)
+	taskQueue := opts.GetTaskQueue()
+	if taskQueue == "" {
+		taskQueue = svc.opts.GetTaskQueue()
+	}
+	if taskQueue != "" {
+		fn.Id("ctx").Op("=").Qual(workflowPkg, "WithWorkflowTaskQueue").Call(g.Id("ctx"), g.Lit(taskQueue))
+	}
+	if timeout := opts.GetRunTimeout(); timeout.IsValid() {
+		fn.Id("ctx").Op("=").Qual(workflowPkg, "WithWorkflowRunTimeout").Call(
+			g.Id("ctx"), g.Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)),
+		).Comment(timeout.AsDuration().String())
+	}
+	if timeout := opts.GetTaskTimeout(); timeout.IsValid() {
+		fn.Id("ctx").Op("=").Qual(workflowPkg, "WithWorkflowTaskTimeout").Call(
+			g.Id("ctx"), g.Id(strconv.FormatInt(timeout.AsDuration().Nanoseconds(), 10)),
+		).Comment(timeout.AsDuration().String())
+	}
+}
+
+// genWorkflowPatch generates a public <Workflow>Patch<Name> function
+func (svc *Service) genWorkflowPatch(f *g.File, workflow, patch string) {
+	name := fmt.Sprintf("%sPatch%s", workflow, patchName(patch))
+	f.Commentf("%s returns true if the %s patch applies to the current %s workflow execution", name, patch, workflow)
+	f.Func().
+		Id(name).
+		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
+		Bool().
+		Block(
+			g.Return(
+				g.Qual(workflowPkg, "GetVersion").Call(
+					g.Id("ctx"),
+					g.Id(fmt.Sprintf("%sChangeID", name)),
+					g.Qual(workflowPkg, "DefaultVersion"),
+					g.Lit(1),
+				).Op("==").Lit(1),
+			),
+		)
+}
+
 // genWorkflowChildRun generates a <Workflow>ChildRun struct
 func (svc *Service) genWorkflowChildRun(f *g.File, workflow string) {
 	// generate child workflow run struct
@@ -354,13 +777,13 @@ func (svc *Service) genWorkflowChildRunGet(f *g.File, workflow string) {
 		).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasOutput {
-				returnVals.Op("*").Id(method.Output.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Output))
 			}
 			returnVals.Error()
 		}).
 		BlockFunc(func(fn *g.Group) {
 			if hasOutput {
-				fn.Var().Id("resp").Id(method.Output.GoIdent.GoName)
+				fn.Var().Id("resp").Add(messageType(method.Output))
 			}
 			fn.If(
 				g.Err().Op(":=").Id("r").Dot("Future").Dot("Get").CallFunc(func(args *g.Group) {
@@ -489,7 +912,7 @@ func (svc *Service) genWorkflowChildRunSignals(f *g.File, workflow string) {
 			ParamsFunc(func(params *g.Group) {
 				params.Id("ctx").Qual(workflowPkg, "Context")
 				if hasInput {
-					params.Id("input").Op("*").Id(handler.Input.GoIdent.GoName)
+					params.Id("input").Op("*").Add(messageType(handler.Input))
 				}
 			}).
 			Params(g.Qual(workflowPkg, "Future")).
@@ -526,13 +949,13 @@ func (svc *Service) genWorkerSignalReceive(f *g.File, signal string) {
 		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasInput {
-				returnVals.Op("*").Id(method.Input.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Input))
 			}
 			returnVals.Bool()
 		}).
 		BlockFunc(func(b *g.Group) {
 			if hasInput {
-				b.Var().Id("resp").Id(method.Input.GoIdent.GoName)
+				b.Var().Id("resp").Add(messageType(method.Input))
 			}
 			b.Id("more").Op(":=").Id("s").Dot("Channel").Dot("Receive").CallFunc(func(args *g.Group) {
 				args.Id("ctx")
@@ -562,14 +985,14 @@ func (svc *Service) genWorkerSignalReceiveAsync(f *g.File, signal string) {
 		Params().
 		ParamsFunc(func(returnVals *g.Group) {
 			if hasInput {
-				returnVals.Op("*").Id(method.Input.GoIdent.GoName)
+				returnVals.Op("*").Add(messageType(method.Input))
 			} else {
 				returnVals.Bool()
 			}
 		}).
 		BlockFunc(func(b *g.Group) {
 			if hasInput {
-				b.Var().Id("resp").Id(method.Input.GoIdent.GoName)
+				b.Var().Id("resp").Add(messageType(method.Input))
 				b.If(
 					g.Id("ok").Op(":=").Id("s").Dot("Channel").Dot("ReceiveAsync").Call(
 						g.Op("&").Id("resp"),
@@ -597,7 +1020,7 @@ func (svc *Service) genWorkerSignalSelect(f *g.File, signal string) {
 			g.Id("sel").Qual(workflowPkg, "Selector"),
 			g.Id("fn").Func().ParamsFunc(func(args *g.Group) {
 				if hasInput {
-					args.Op("*").Id(method.Input.GoIdent.GoName)
+					args.Op("*").Add(messageType(method.Input))
 				}
 			}),
 		).
@@ -643,7 +1066,7 @@ func (svc *Service) genWorkerSignalExternal(f *g.File, signal string) {
 			args.Id("workflowID").String()
 			args.Id("runID").String()
 			if hasInput {
-				args.Id("req").Op("*").Id(method.Input.GoIdent.GoName)
+				args.Id("req").Op("*").Add(messageType(method.Input))
 			}
 		}).
 		Params(g.Qual(workflowPkg, "Future")).
@@ -663,3 +1086,115 @@ func (svc *Service) genWorkerSignalExternal(f *g.File, signal string) {
 			),
 		)
 }
+
+// genWorkerSignalRestore generates a <Signal>Signal restore method that delivers signals carried
+// over from a previous run ahead of newly received signals
+func (svc *Service) genWorkerSignalRestore(f *g.File, signal string) {
+	method := svc.methods[signal]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("restore delivers %s signals carried over from a previous run ahead of any newly received signals", signal)
+	f.Func().
+		Params(g.Id("s").Op("*").Id(fmt.Sprintf("%sSignal", signal))).
+		Id("restore").
+		Params(
+			g.Id("ctx").Qual(workflowPkg, "Context"),
+			g.Id("carried").Index().Op("*").Qual(anyPkg, "Any"),
+		).
+		Error().
+		BlockFunc(func(fn *g.Group) {
+			fn.If(g.Len(g.Id("carried")).Op("==").Lit(0)).Block(
+				g.Return(g.Nil()),
+			)
+			fn.Id("ch").Op(":=").Qual(workflowPkg, "NewBufferedChannel").Call(g.Id("ctx"), g.Len(g.Id("carried")))
+			loopVars := g.Range().Id("carried")
+			if hasInput {
+				loopVars = g.List(g.Id("_"), g.Id("v")).Op(":=").Range().Id("carried")
+			}
+			fn.For(loopVars).BlockFunc(func(loop *g.Group) {
+				if hasInput {
+					loop.Var().Id("req").Add(messageType(method.Input))
+					loop.If(
+						g.Err().Op(":=").Id("v").Dot("UnmarshalTo").Call(g.Op("&").Id("req")),
+						g.Err().Op("!=").Nil(),
+					).Block(
+						g.Return(g.Qual("fmt", "Errorf").Call(g.Lit("error restoring %s signal: %w"), g.Id(fmt.Sprintf("%sSignalName", signal)), g.Err())),
+					)
+					loop.Id("ch").Dot("SendAsync").Call(g.Op("&").Id("req"))
+				} else {
+					loop.Id("ch").Dot("SendAsync").Call(g.Nil())
+				}
+			})
+			fn.Id("signals").Op(":=").Id("s").Dot("Channel")
+			fn.Qual(workflowPkg, "Go").Call(
+				g.Id("ctx"),
+				g.Func().Params(g.Id("ctx").Qual(workflowPkg, "Context")).Block(
+					g.For().BlockFunc(func(loop *g.Group) {
+						if hasInput {
+							loop.Var().Id("req").Add(messageType(method.Input))
+							loop.Id("signals").Dot("Receive").Call(g.Id("ctx"), g.Op("&").Id("req"))
+							loop.Id("ch").Dot("Send").Call(g.Id("ctx"), g.Op("&").Id("req"))
+						} else {
+							loop.Id("signals").Dot("Receive").Call(g.Id("ctx"), g.Nil())
+							loop.Id("ch").Dot("Send").Call(g.Id("ctx"), g.Nil())
+						}
+					}),
+				),
+			)
+			fn.Id("s").Dot("Channel").Op("=").Id("ch")
+			fn.Return(g.Nil())
+		})
+}
+
+// genWorkerSignalDrain generates a <Signal>Signal drain method that receives all pending
+// signals without blocking so that they can be carried over to a new run
+func (svc *Service) genWorkerSignalDrain(f *g.File, signal string) {
+	method := svc.methods[signal]
+	hasInput := !isEmpty(method.Input)
+
+	f.Commentf("drain receives all pending %s signals without blocking so that they can be carried over to a new run", signal)
+	f.Func().
+		Params(g.Id("s").Op("*").Id(fmt.Sprintf("%sSignal", signal))).
+		Id("drain").
+		Params(g.Id("ctx").Qual(workflowPkg, "Context")).
+		Params(g.Index().Op("*").Qual(anyPkg, "Any"), g.Error()).
+		BlockFunc(func(fn *g.Group) {
+			fn.Var().Id("carried").Index().Op("*").Qual(anyPkg, "Any")
+			fn.Comment("restored signals may still be buffered on the underlying signal channel")
+			fn.For(
+				g.List(g.Id("_"), g.Id("ch")).Op(":=").Range().Index().Qual(workflowPkg, "ReceiveChannel").Values(
+					g.Id("s").Dot("Channel"),
+					g.Qual(workflowPkg, "GetSignalChannel").Call(g.Id("ctx"), g.Id(fmt.Sprintf("%sSignalName", signal))),
+				),
+			).Block(
+				g.For().BlockFunc(func(loop *g.Group) {
+					if hasInput {
+						loop.Var().Id("req").Add(messageType(method.Input))
+						loop.If(g.Op("!").Id("ch").Dot("ReceiveAsync").Call(g.Op("&").Id("req"))).Block(g.Break())
+						loop.List(g.Id("v"), g.Err()).Op(":=").Qual(anyPkg, "New").Call(g.Op("&").Id("req"))
+					} else {
+						loop.If(g.Op("!").Id("ch").Dot("ReceiveAsync").Call(g.Nil())).Block(g.Break())
+						loop.List(g.Id("v"), g.Err()).Op(":=").Qual(anyPkg, "New").Call(g.Op("&").Qual(emptyPkg, "Empty").Values())
+					}
+					loop.If(g.Err().Op("!=").Nil()).Block(
+						g.Return(g.Nil(), g.Qual("fmt", "Errorf").Call(g.Lit("error draining %s signal: %w"), g.Id(fmt.Sprintf("%sSignalName", signal)), g.Err())),
+					)
+					loop.Id("carried").Op("=").Append(g.Id("carried"), g.Id("v"))
+				}),
+			)
+			fn.Return(g.Id("carried"), g.Nil())
+		})
+}
+
+// patchName converts a patch into the exported identifier suffix used by its generated helpers
+func patchName(patch string) string {
+	var b strings.Builder
+	for _, part := range strings.FieldsFunc(patch, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		runes := []rune(part)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+	return b.String()
+}
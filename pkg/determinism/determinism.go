@@ -0,0 +1,240 @@
+// Package determinism provides a go/analysis analyzer that reports non-deterministic code
+// reachable from implementations of generated <Workflow>Workflow interfaces
+package determinism
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const workflowPkg = "go.temporal.io/sdk/workflow"
+
+// Analyzer reports non-deterministic calls and statements reachable from the methods of types
+// that implement a generated <Workflow>Workflow interface. Only functions declared in the
+// analyzed package are traversed, and functions passed to workflow.SideEffect or
+// workflow.MutableSideEffect are ignored
+var Analyzer = &analysis.Analyzer{
+	Name: "determinism",
+	Doc:  "reports non-deterministic code reachable from generated workflow interface implementations",
+	Run:  run,
+}
+
+// nondeterministic describes functions that must not be called from workflow code, keyed by
+// package path and function name, with an optional "*" wildcard function name
+var nondeterministic = map[string]map[string]string{
+	"time": {
+		"After":     "use workflow.NewTimer",
+		"AfterFunc": "use workflow.NewTimer",
+		"NewTicker": "use workflow.NewTimer",
+		"NewTimer":  "use workflow.NewTimer",
+		"Now":       "use workflow.Now",
+		"Since":     "use workflow.Now",
+		"Sleep":     "use workflow.Sleep",
+		"Tick":      "use workflow.NewTimer",
+		"Until":     "use workflow.Now",
+	},
+	"crypto/rand":  {"*": "use workflow.SideEffect"},
+	"math/rand":    {"*": "use workflow.SideEffect"},
+	"math/rand/v2": {"*": "use workflow.SideEffect"},
+	"os": {
+		"Getenv":    "use workflow.SideEffect",
+		"LookupEnv": "use workflow.SideEffect",
+	},
+}
+
+// sideEffects describes workflow functions whose function arguments are not replayed
+var sideEffects = map[string]bool{
+	"MutableSideEffect": true,
+	"SideEffect":        true,
+}
+
+// checker traverses the workflow code of a single package
+type checker struct {
+	pass     *analysis.Pass
+	decls    map[*types.Func]*ast.FuncDecl
+	visited  map[*ast.FuncDecl]bool
+	reported map[token.Pos]bool
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	ifaces := workflowInterfaces(pass.Pkg)
+	if len(ifaces) == 0 {
+		return nil, nil
+	}
+
+	c := &checker{
+		pass:     pass,
+		decls:    map[*types.Func]*ast.FuncDecl{},
+		visited:  map[*ast.FuncDecl]bool{},
+		reported: map[token.Pos]bool{},
+	}
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				if obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func); ok {
+					c.decls[obj] = fn
+				}
+			}
+		}
+	}
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+		if _, ok := tn.Type().Underlying().(*types.Interface); ok {
+			continue
+		}
+		ptr := types.NewPointer(tn.Type())
+		for _, iface := range ifaces {
+			if !types.Implements(ptr, iface.Type().Underlying().(*types.Interface)) {
+				continue
+			}
+			root := fmt.Sprintf("%s.%s", tn.Name(), iface.Name())
+			methods := iface.Type().Underlying().(*types.Interface)
+			for i := 0; i < methods.NumMethods(); i++ {
+				obj, _, _ := types.LookupFieldOrMethod(ptr, true, pass.Pkg, methods.Method(i).Name())
+				if fn, ok := obj.(*types.Func); ok {
+					if decl, ok := c.decls[fn]; ok {
+						c.check(decl, root)
+					}
+				}
+			}
+		}
+	}
+	return nil, nil
+}
+
+// check reports non-deterministic code in the given function and any package-local functions
+// that it calls
+func (c *checker) check(decl *ast.FuncDecl, root string) {
+	if c.visited[decl] {
+		return
+	}
+	c.visited[decl] = true
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GoStmt:
+			c.report(n.Pos(), root, "go statement", "use workflow.Go")
+		case *ast.SelectStmt:
+			c.report(n.Pos(), root, "select statement", "use workflow.Selector")
+		case *ast.SendStmt:
+			c.report(n.Pos(), root, "channel send", "use workflow.Channel")
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				c.report(n.Pos(), root, "channel receive", "use workflow.Channel")
+			}
+		case *ast.RangeStmt:
+			switch c.pass.TypesInfo.TypeOf(n.X).Underlying().(type) {
+			case *types.Map:
+				c.report(n.Pos(), root, "map iteration", "iterate over sorted keys")
+			case *types.Chan:
+				c.report(n.Pos(), root, "channel range", "use workflow.Channel")
+			}
+		case *ast.CallExpr:
+			fn := typeutil.StaticCallee(c.pass.TypesInfo, n)
+			if fn == nil || fn.Pkg() == nil {
+				return true
+			}
+			path := fn.Pkg().Path()
+			if path == workflowPkg && sideEffects[fn.Name()] {
+				return false
+			}
+			if funcs, ok := nondeterministic[path]; ok && fn.Type().(*types.Signature).Recv() == nil {
+				if hint, ok := funcs[fn.Name()]; ok {
+					c.report(n.Pos(), root, fmt.Sprintf("call to %s.%s", path, fn.Name()), hint)
+				} else if hint, ok := funcs["*"]; ok {
+					c.report(n.Pos(), root, fmt.Sprintf("call to %s.%s", path, fn.Name()), hint)
+				}
+			}
+			if decl, ok := c.decls[fn.Origin()]; ok {
+				c.check(decl, root)
+			}
+		}
+		return true
+	})
+}
+
+// report records a diagnostic at the given position, if one has not already been reported
+func (c *checker) report(pos token.Pos, root, what, hint string) {
+	if c.reported[pos] {
+		return
+	}
+	c.reported[pos] = true
+	c.pass.Reportf(pos, "non-deterministic %s in workflow code reachable from %s; %s", what, root, hint)
+}
+
+// workflowInterfaces returns the generated <Workflow>Workflow interfaces declared in the given
+// package or its direct imports. A generated interface is identified by a matching
+// <Workflow>WorkflowName constant and an Execute(workflow.Context) method
+func workflowInterfaces(pkg *types.Package) (ifaces []*types.TypeName) {
+	for _, p := range append([]*types.Package{pkg}, pkg.Imports()...) {
+		ctx := workflowContext(p)
+		if ctx == nil {
+			continue
+		}
+		scope := p.Scope()
+		for _, name := range scope.Names() {
+			if !strings.HasSuffix(name, "Workflow") {
+				continue
+			}
+			tn, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			iface, ok := tn.Type().Underlying().(*types.Interface)
+			if !ok {
+				continue
+			}
+			if _, ok := scope.Lookup(name + "Name").(*types.Const); !ok {
+				continue
+			}
+			if !hasExecute(iface, ctx) {
+				continue
+			}
+			ifaces = append(ifaces, tn)
+		}
+	}
+	return ifaces
+}
+
+// workflowContext returns the workflow.Context type imported by the given package, or nil if the
+// package does not import the workflow package
+func workflowContext(pkg *types.Package) types.Type {
+	for _, imp := range pkg.Imports() {
+		if imp.Path() != workflowPkg {
+			continue
+		}
+		if tn, ok := imp.Scope().Lookup("Context").(*types.TypeName); ok {
+			return tn.Type()
+		}
+	}
+	return nil
+}
+
+// hasExecute returns true if the interface declares an Execute method that accepts the given
+// workflow.Context type
+func hasExecute(iface *types.Interface, ctx types.Type) bool {
+	for i := 0; i < iface.NumMethods(); i++ {
+		m := iface.Method(i)
+		if m.Name() != "Execute" {
+			continue
+		}
+		params := m.Type().(*types.Signature).Params()
+		if params.Len() == 0 {
+			return false
+		}
+		// workflow.Context is an alias of internal.Context, which types.Identical resolves
+		return types.Identical(params.At(0).Type(), ctx)
+	}
+	return false
+}
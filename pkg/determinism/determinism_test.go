@@ -0,0 +1,12 @@
+package determinism_test
+
+import (
+	"testing"
+
+	"github.com/cludden/protoc-gen-go-temporal/pkg/determinism"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), determinism.Analyzer, "example")
+}
@@ -0,0 +1,62 @@
+package example
+
+import (
+	"math/rand"
+	"time"
+
+	"example/examplepb"
+	"go.temporal.io/sdk/workflow"
+)
+
+var _ examplepb.FooWorkflow = &foo{}
+
+type foo struct {
+	counts map[string]int
+	ch     chan int
+}
+
+func (f *foo) Execute(ctx workflow.Context) error {
+	_ = time.Now() // want `non-deterministic call to time.Now in workflow code reachable from foo.FooWorkflow; use workflow.Now`
+	_ = workflow.Now(ctx)
+	go f.helper()             // want `non-deterministic go statement`
+	for k := range f.counts { // want `non-deterministic map iteration`
+		_ = k
+	}
+	select { // want `non-deterministic select statement`
+	case <-f.ch: // want `non-deterministic channel receive`
+	default:
+	}
+	_ = workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return rand.Int()
+	})
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		time.Sleep(time.Second) // want `non-deterministic call to time.Sleep`
+	})
+	f.helper()
+	return nil
+}
+
+func (f *foo) Status() (string, error) {
+	f.ch <- 1 // want `non-deterministic channel send`
+	return "", nil
+}
+
+func (f *foo) helper() {
+	_ = rand.Intn(10) // want `non-deterministic call to math/rand.Intn`
+}
+
+// bar implements BarWorkflow, which is not a generated interface
+type bar struct{}
+
+func (b *bar) Execute(ctx workflow.Context) error {
+	_ = time.Now()
+	return nil
+}
+
+// activities are not workflow code
+type activities struct{}
+
+func (a *activities) Status() (string, error) {
+	_ = time.Now()
+	return "", nil
+}
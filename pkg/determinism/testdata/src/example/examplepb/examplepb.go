@@ -0,0 +1,16 @@
+// Package examplepb mimics generated workflow interfaces
+package examplepb
+
+import "go.temporal.io/sdk/workflow"
+
+const FooWorkflowName = "example.v1.Foo"
+
+type FooWorkflow interface {
+	Execute(ctx workflow.Context) error
+	Status() (string, error)
+}
+
+// BarWorkflow has no BarWorkflowName constant and is therefore not a generated interface
+type BarWorkflow interface {
+	Execute(ctx workflow.Context) error
+}
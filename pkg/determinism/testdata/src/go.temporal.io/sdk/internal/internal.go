@@ -0,0 +1,4 @@
+// Package internal is a minimal stub of go.temporal.io/sdk/internal
+package internal
+
+type Context interface{}
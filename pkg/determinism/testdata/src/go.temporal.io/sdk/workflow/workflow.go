@@ -0,0 +1,17 @@
+// Package workflow is a minimal stub of go.temporal.io/sdk/workflow
+package workflow
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/internal"
+)
+
+// Context is an alias of internal.Context, as in the sdk
+type Context = internal.Context
+
+func Go(ctx Context, f func(ctx Context)) {}
+
+func Now(ctx Context) time.Time { return time.Time{} }
+
+func SideEffect(ctx Context, f func(ctx Context) interface{}) interface{} { return f(ctx) }
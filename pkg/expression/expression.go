@@ -2,7 +2,9 @@ package expression
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/alecthomas/participle/v2"
@@ -104,6 +106,63 @@ func ParseExpression(input string) (*Expression, error) {
 	return expr, nil
 }
 
+// Paths returns the message field paths referenced by the expression, where each path is a list
+// of json field names
+func (e *Expression) Paths() (paths [][]string, err error) {
+	for _, fragment := range e.Fragments {
+		if fragment.Expr == nil || fragment.Expr.m == nil {
+			continue
+		}
+		targets, err := queryTargets(fragment.Expr.m)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, targets...)
+	}
+	return paths, nil
+}
+
+// errUnsupportedBloblang is returned when the bloblang mapping executor cannot be inspected
+var errUnsupportedBloblang = errors.New("unable to inspect bloblang query targets, the bloblang version is not supported")
+
+// queryTargets returns the value paths referenced by a bloblang mapping. The public bloblang
+// package does not expose query targets, so the underlying mapping executor is inspected via
+// reflection, returning an error if its shape is not recognized
+func queryTargets(m *bloblang.Executor) (paths [][]string, err error) {
+	unwrap := reflect.ValueOf(m.XUnwrapper()).MethodByName("Unwrap")
+	if !unwrap.IsValid() || unwrap.Type().NumIn() != 0 || unwrap.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("%w: unexpected Unwrap method", errUnsupportedBloblang)
+	}
+	fn := unwrap.Call(nil)[0].MethodByName("QueryTargets")
+	if !fn.IsValid() || fn.Type().NumIn() != 1 || fn.Type().NumOut() != 2 || fn.Type().Out(1).Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%w: unexpected QueryTargets method", errUnsupportedBloblang)
+	}
+	targets := fn.Call([]reflect.Value{reflect.Zero(fn.Type().In(0))})[1]
+	for i := 0; i < targets.Len(); i++ {
+		target := targets.Index(i)
+		if target.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("%w: unexpected query target %s", errUnsupportedBloblang, target.Type())
+		}
+		typ, path := target.FieldByName("Type"), target.FieldByName("Path")
+		if !typ.IsValid() || typ.Kind() != reflect.Int || !path.IsValid() {
+			return nil, fmt.Errorf("%w: unexpected query target %s", errUnsupportedBloblang, target.Type())
+		}
+		p, ok := path.Interface().([]string)
+		if !ok {
+			return nil, fmt.Errorf("%w: unexpected query target path %s", errUnsupportedBloblang, path.Type())
+		}
+
+		// only value targets (e.g. this.foo) reference message fields
+		if typ.Int() == targetValue && len(p) > 0 {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
+
+// targetValue mirrors the bloblang query.TargetValue target type
+const targetValue = 1
+
 // marshalMessage marshals a proto message into a map[string]any value
 func marshalMessage(msg protoreflect.Message) (any, error) {
 	structured := make(map[string]any)
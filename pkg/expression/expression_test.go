@@ -118,3 +118,28 @@ func TestExpression(t *testing.T) {
 		}
 	}
 }
+
+func TestExpressionPaths(t *testing.T) {
+	require := require.New(t)
+
+	cases := []struct {
+		expr     string
+		expected [][]string
+	}{
+		{expr: "test", expected: nil},
+		{expr: "test/${!uuid_v4()}", expected: nil},
+		{expr: "test/${!id}/${!uuid_v4()}", expected: [][]string{{"id"}}},
+		{expr: "test/${!this.outerSingle.innerSingle.bar.uppercase()}", expected: [][]string{{"outerSingle", "innerSingle", "bar"}}},
+		{expr: `test/${!intField.or(this.id).string()}`, expected: [][]string{{"intField"}, {"id"}}},
+		{expr: `test/${!outerList.0.foo}`, expected: [][]string{{"outerList", "0", "foo"}}},
+	}
+
+	for _, c := range cases {
+		expr, err := expression.ParseExpression(c.expr)
+		require.NoError(err)
+		// fails if a bloblang upgrade changes the shape of the inspected query targets
+		paths, err := expr.Paths()
+		require.NoError(err, c.expr)
+		require.Equal(c.expected, paths, c.expr)
+	}
+}
@@ -0,0 +1,147 @@
+package grpcutil
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC metadata keys used to identify a target workflow execution
+const (
+	WorkflowIDKey = "temporal-workflow-id"
+	RunIDKey      = "temporal-run-id"
+)
+
+// nonRetryableCodes describes gRPC status codes that indicate a request will continue to fail
+// if retried without modification
+var nonRetryableCodes = map[codes.Code]struct{}{
+	codes.InvalidArgument:    {},
+	codes.NotFound:           {},
+	codes.AlreadyExists:      {},
+	codes.PermissionDenied:   {},
+	codes.FailedPrecondition: {},
+	codes.OutOfRange:         {},
+	codes.Unimplemented:      {},
+	codes.Unauthenticated:    {},
+}
+
+// WorkflowID returns the workflow id included in the incoming gRPC metadata, if present
+func WorkflowID(ctx context.Context) string {
+	return firstValue(ctx, WorkflowIDKey)
+}
+
+// RunID returns the workflow run id included in the incoming gRPC metadata, if present
+func RunID(ctx context.Context) string {
+	return firstValue(ctx, RunIDKey)
+}
+
+// InvokeActivity invokes a unary rpc on behalf of an activity. The activity context, and therefore
+// its deadline and cancellation, is propagated to the request, and the activity heartbeats while
+// the request is in flight if a heartbeat timeout is configured. Heartbeating is skipped when ctx
+// is not an activity context. gRPC status errors are converted via ToApplicationError
+func InvokeActivity(ctx context.Context, conn grpc.ClientConnInterface, method string, req, resp any) error {
+	if isActivity(ctx) {
+		if timeout := activity.GetInfo(ctx).HeartbeatTimeout; timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			defer cancel()
+			go heartbeat(ctx, timeout/2)
+		}
+	}
+	return ToApplicationError(conn.Invoke(ctx, method, req, resp))
+}
+
+// isActivity returns true if ctx is an activity context. The sdk panics when activity info is
+// requested from any other context and does not expose an equivalent check in this version
+func isActivity(ctx context.Context) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	activity.GetInfo(ctx)
+	return true
+}
+
+// RequireWorkflowID returns the workflow id included in the incoming gRPC metadata, or an
+// InvalidArgument status error if absent
+func RequireWorkflowID(ctx context.Context) (string, error) {
+	workflowID := WorkflowID(ctx)
+	if workflowID == "" {
+		return "", status.Errorf(codes.InvalidArgument, "missing required %s metadata", WorkflowIDKey)
+	}
+	return workflowID, nil
+}
+
+// ToStatusError converts an error returned by a temporal client into a gRPC status error
+func ToStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var svcerr serviceerror.ServiceError
+	if errors.As(err, &svcerr) {
+		st := serviceerror.ToStatus(svcerr)
+		return status.Error(st.Code(), st.Message())
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	if errors.Is(err, context.Canceled) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// ToApplicationError converts a gRPC status error into a temporal application error whose type
+// is the name of the status code. Errors with status codes that indicate the request will not
+// succeed on retry are marked as non-retryable
+func ToApplicationError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	if _, ok := nonRetryableCodes[st.Code()]; ok {
+		return temporal.NewNonRetryableApplicationError(st.Message(), st.Code().String(), err)
+	}
+	return temporal.NewApplicationErrorWithCause(st.Message(), st.Code().String(), err)
+}
+
+// heartbeat records activity heartbeats at the given interval until the context is done
+func heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			activity.RecordHeartbeat(ctx)
+		}
+	}
+}
+
+// firstValue returns the first value of the given key in the incoming gRPC metadata
+func firstValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
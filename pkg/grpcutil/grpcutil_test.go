@@ -0,0 +1,108 @@
+package grpcutil_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetadata(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	require.Equal("", grpcutil.WorkflowID(ctx))
+	require.Equal("", grpcutil.RunID(ctx))
+	_, err := grpcutil.RequireWorkflowID(ctx)
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(
+		grpcutil.WorkflowIDKey, "foo",
+		grpcutil.RunIDKey, "bar",
+	))
+	require.Equal("foo", grpcutil.WorkflowID(ctx))
+	require.Equal("bar", grpcutil.RunID(ctx))
+	workflowID, err := grpcutil.RequireWorkflowID(ctx)
+	require.NoError(err)
+	require.Equal("foo", workflowID)
+}
+
+func TestToStatusError(t *testing.T) {
+	require := require.New(t)
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied},
+		{serviceerror.NewNotFound("not found"), codes.NotFound},
+		{fmt.Errorf("wrapped: %w", serviceerror.NewWorkflowExecutionAlreadyStarted("started", "", "")), codes.AlreadyExists},
+		{context.DeadlineExceeded, codes.DeadlineExceeded},
+		{context.Canceled, codes.Canceled},
+		{errors.New("unknown"), codes.Unknown},
+	}
+	for _, c := range cases {
+		require.Equal(c.code, status.Code(grpcutil.ToStatusError(c.err)), c.err)
+	}
+}
+
+func TestToApplicationError(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(grpcutil.ToApplicationError(nil))
+
+	plain := errors.New("plain")
+	require.Equal(plain, grpcutil.ToApplicationError(plain))
+
+	cases := []struct {
+		code         codes.Code
+		nonRetryable bool
+	}{
+		{codes.InvalidArgument, true},
+		{codes.NotFound, true},
+		{codes.PermissionDenied, true},
+		{codes.Unavailable, false},
+		{codes.Internal, false},
+	}
+	for _, c := range cases {
+		err := grpcutil.ToApplicationError(status.Error(c.code, "oops"))
+		var appErr *temporal.ApplicationError
+		require.ErrorAs(err, &appErr)
+		require.Equal(c.code.String(), appErr.Type())
+		require.Equal(c.nonRetryable, appErr.NonRetryable(), c.code)
+		require.Equal(c.code, status.Code(errors.Unwrap(err)))
+	}
+}
+
+func TestInvokeActivityOutsideActivity(t *testing.T) {
+	require := require.New(t)
+
+	conn := &fakeConn{err: status.Error(codes.NotFound, "oops")}
+	err := grpcutil.InvokeActivity(context.Background(), conn, "/foo.Foo/Bar", "req", nil)
+	require.Equal("/foo.Foo/Bar", conn.method)
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(err, &appErr)
+	require.Equal(codes.NotFound.String(), appErr.Type())
+}
+
+// fakeConn records the invoked method and returns a canned error
+type fakeConn struct {
+	grpc.ClientConnInterface
+	method string
+	err    error
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	c.method = method
+	return c.err
+}
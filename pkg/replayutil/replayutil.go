@@ -0,0 +1,32 @@
+package replayutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+)
+
+// ReplayDir replays every JSON workflow history file in the given directory using the given
+// replayer. Replay errors are annotated with the name of the offending file and joined. The
+// logger is optional
+func ReplayDir(replayer worker.WorkflowReplayer, logger log.Logger, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("error reading history directory: %w", err)
+	}
+
+	var errs error
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		if err := replayer.ReplayWorkflowHistoryFromJSONFile(logger, filepath.Join(dir, entry.Name())); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("error replaying %s: %w", entry.Name(), err))
+		}
+	}
+	return errs
+}
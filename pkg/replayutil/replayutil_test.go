@@ -0,0 +1,50 @@
+package replayutil_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/worker"
+)
+
+// testReplayer records replayed files and fails those listed in failures
+type testReplayer struct {
+	worker.WorkflowReplayer
+	failures map[string]bool
+	replayed []string
+}
+
+func (r *testReplayer) ReplayWorkflowHistoryFromJSONFile(logger log.Logger, jsonfileName string) error {
+	name := filepath.Base(jsonfileName)
+	r.replayed = append(r.replayed, name)
+	if r.failures[name] {
+		return errors.New("nondeterministic workflow")
+	}
+	return nil
+}
+
+func TestReplayDir(t *testing.T) {
+	require := require.New(t)
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.json", "b.json", "README.md"} {
+		require.NoError(os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600))
+	}
+	require.NoError(os.Mkdir(filepath.Join(dir, "nested.json"), 0o700))
+
+	r := &testReplayer{}
+	require.NoError(replayutil.ReplayDir(r, nil, dir))
+	require.Equal([]string{"a.json", "b.json"}, r.replayed)
+
+	r = &testReplayer{failures: map[string]bool{"b.json": true}}
+	err := replayutil.ReplayDir(r, nil, dir)
+	require.ErrorContains(err, "error replaying b.json: nondeterministic workflow")
+	require.NotContains(err.Error(), "a.json")
+
+	require.ErrorContains(replayutil.ReplayDir(r, nil, filepath.Join(dir, "missing")), "error reading history directory")
+}
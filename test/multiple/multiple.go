@@ -0,0 +1,60 @@
+package multiple
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	multiplepb "github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	"github.com/cludden/protoc-gen-go-temporal/gen/multipletemporal"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Register registers the workflows and activities of both services
+func Register(r worker.Registry) {
+	multipletemporal.RegisterGreeterWorkflows(r, &GreeterWorkflows{})
+	multipletemporal.RegisterGreeterActivities(r, &GreeterActivities{})
+	multipletemporal.RegisterCounterWorkflows(r, &CounterWorkflows{})
+	multipletemporal.RegisterCounterActivities(r, &CounterActivities{})
+}
+
+type GreeterWorkflows struct{}
+
+func (w *GreeterWorkflows) Greet(ctx workflow.Context, in *multipletemporal.GreetInput) (multipletemporal.GreetWorkflow, error) {
+	return &greet{in}, nil
+}
+
+type greet struct {
+	*multipletemporal.GreetInput
+}
+
+func (w *greet) Execute(ctx workflow.Context) (*multiplepb.GreetResponse, error) {
+	return multipletemporal.FormatGreeting(ctx, nil, w.Req).Get(ctx)
+}
+
+type GreeterActivities struct{}
+
+func (a *GreeterActivities) FormatGreeting(ctx context.Context, req *multiplepb.GreetRequest) (*multiplepb.GreetResponse, error) {
+	return &multiplepb.GreetResponse{Greeting: fmt.Sprintf("Hello, %s!", req.GetName())}, nil
+}
+
+type CounterWorkflows struct{}
+
+func (w *CounterWorkflows) Count(ctx workflow.Context, in *multipletemporal.CountInput) (multipletemporal.CountWorkflow, error) {
+	return &count{in}, nil
+}
+
+type count struct {
+	*multipletemporal.CountInput
+}
+
+func (w *count) Execute(ctx workflow.Context) (*multiplepb.CountResponse, error) {
+	return multipletemporal.CountCharacters(ctx, nil, w.Req).Get(ctx)
+}
+
+type CounterActivities struct{}
+
+func (a *CounterActivities) CountCharacters(ctx context.Context, req *multiplepb.CountRequest) (*multiplepb.CountResponse, error) {
+	return &multiplepb.CountResponse{Count: int64(utf8.RuneCountInString(req.GetValue()))}, nil
+}
@@ -0,0 +1,60 @@
+package multiple_test
+
+import (
+	"testing"
+
+	multiplepb "github.com/cludden/protoc-gen-go-temporal/gen/multiple"
+	"github.com/cludden/protoc-gen-go-temporal/gen/multipletemporal"
+	"github.com/cludden/protoc-gen-go-temporal/test/multiple"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestMultipleServices(t *testing.T) {
+	cases := []struct {
+		name     string
+		workflow string
+		req      any
+		resp     any
+		expected any
+	}{
+		{
+			name:     "greet",
+			workflow: multipletemporal.GreetWorkflowName,
+			req:      &multiplepb.GreetRequest{Name: "Temporal"},
+			resp:     &multiplepb.GreetResponse{},
+			expected: "Hello, Temporal!",
+		},
+		{
+			name:     "count",
+			workflow: multipletemporal.CountWorkflowName,
+			req:      &multiplepb.CountRequest{Value: "Temporal"},
+			resp:     &multiplepb.CountResponse{},
+			expected: int64(8),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require := require.New(t)
+			var s testsuite.WorkflowTestSuite
+			env := s.NewTestWorkflowEnvironment()
+			multiple.Register(env)
+
+			env.ExecuteWorkflow(c.workflow, c.req)
+			require.True(env.IsWorkflowCompleted())
+			require.NoError(env.GetWorkflowError())
+			require.NoError(env.GetWorkflowResult(c.resp))
+			switch resp := c.resp.(type) {
+			case *multiplepb.GreetResponse:
+				require.Equal(c.expected, resp.GetGreeting())
+			case *multiplepb.CountResponse:
+				require.Equal(c.expected, resp.GetCount())
+			}
+		})
+	}
+}
+
+func TestReplayGreeterHistories(t *testing.T) {
+	require.NoError(t, multipletemporal.ReplayGreeterHistories(&multiple.GreeterWorkflows{}, "testdata"))
+}
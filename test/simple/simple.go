@@ -2,6 +2,7 @@ package simple
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	simplepb "github.com/cludden/protoc-gen-go-temporal/gen/simple"
@@ -31,6 +32,11 @@ func (w *Workflows) SomeWorkflow1(ctx workflow.Context, in *simplepb.SomeWorkflo
 func (s *someWorkflow1) Execute(ctx workflow.Context) (*simplepb.SomeWorkflow1Response, error) {
 	s.events = append(s.events, "started with param "+s.Req.RequestVal)
 
+	// Apply patched behavior to new executions
+	if simplepb.SomeWorkflow1PatchV2Billing(ctx) {
+		s.events = append(s.events, "v2-billing patch applied")
+	}
+
 	// Call regular activity
 	resp, err := simplepb.SomeActivity3(ctx, nil,
 		&simplepb.SomeActivity3Request{RequestVal: "some activity param"}).Get(ctx)
@@ -75,6 +81,20 @@ func (s *someWorkflow1) SomeQuery2(req *simplepb.SomeQuery2Request) (*simplepb.S
 	}, nil
 }
 
+func (s *someWorkflow1) SomeUpdate1(ctx workflow.Context, req *simplepb.SomeUpdate1Request) (*simplepb.SomeUpdate1Response, error) {
+	s.events = append(s.events, "some update 1 with param "+req.RequestVal)
+	return &simplepb.SomeUpdate1Response{
+		ResponseVal: strings.Join(s.events, "\n"),
+	}, nil
+}
+
+func (s *someWorkflow1) ValidateSomeUpdate1(ctx workflow.Context, req *simplepb.SomeUpdate1Request) error {
+	if req.RequestVal == "" {
+		return errors.New("request_val is required")
+	}
+	return nil
+}
+
 type Activities struct{}
 
 var ActivityEvents []string
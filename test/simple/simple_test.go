@@ -2,16 +2,39 @@ package simple_test
 
 import (
 	"context"
+	"errors"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 	simplepb "github.com/cludden/protoc-gen-go-temporal/gen/simple"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/expression"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/grpcutil"
+	"github.com/cludden/protoc-gen-go-temporal/pkg/replayutil"
 	"github.com/cludden/protoc-gen-go-temporal/test/simple"
 	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/interceptor"
+	"go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestSomeWorkflow1(t *testing.T) {
@@ -89,3 +112,703 @@ func TestSomeWorkflow1(t *testing.T) {
 	require.NoError(err)
 	require.NotNil(resp)
 }
+
+func TestSomeUpdate1(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	simple.Register(env)
+
+	// delay activity completion so that updates are delivered to a running workflow
+	env.OnActivity(simplepb.SomeActivity3ActivityName, mock.Anything, mock.Anything).
+		After(time.Minute).
+		Return(&simplepb.SomeActivity3Response{ResponseVal: "some response"}, nil)
+
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(simplepb.SomeUpdate1UpdateName, &testUpdateCallbacks{
+			reject: func(err error) {
+				require.ErrorContains(err, "request_val is required")
+			},
+			complete: func(v any, err error) {
+				require.Fail("expected update to be rejected")
+			},
+		}, &simplepb.SomeUpdate1Request{})
+
+		env.UpdateWorkflow(simplepb.SomeUpdate1UpdateName, &testUpdateCallbacks{
+			reject: func(err error) {
+				require.NoError(err)
+			},
+			complete: func(v any, err error) {
+				require.NoError(err)
+				resp, ok := v.(*simplepb.SomeUpdate1Response)
+				require.True(ok)
+				require.Contains(resp.GetResponseVal(), "some update 1 with param foo")
+			},
+		}, &simplepb.SomeUpdate1Request{RequestVal: "foo"})
+	}, time.Second)
+
+	env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{
+		Id:         "foo",
+		RequestVal: "some request",
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+
+	var resp simplepb.SomeWorkflow1Response
+	require.NoError(env.GetWorkflowResult(&resp))
+	require.Contains(resp.GetResponseVal(), "some update 1 with param foo")
+}
+
+// testUpdateCallbacks implements testsuite.UpdateCallbacks
+type testUpdateCallbacks struct {
+	reject   func(error)
+	complete func(any, error)
+}
+
+func (c *testUpdateCallbacks) Accept() {}
+
+func (c *testUpdateCallbacks) Reject(err error) {
+	c.reject(err)
+}
+
+func (c *testUpdateCallbacks) Complete(success any, err error) {
+	c.complete(success, err)
+}
+
+func TestSomeWorkflow1TestEnv(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := simplepb.NewTestEnv(suite.NewTestWorkflowEnvironment(), &simple.Workflows{}, nil)
+	env.OnSomeActivity3(&simplepb.SomeActivity3Request{RequestVal: "some activity param"}).
+		Return(&simplepb.SomeActivity3Response{ResponseVal: "mocked response"}, nil)
+	env.OnSomeActivity3(&simplepb.SomeActivity3Request{RequestVal: "some local activity param"}).
+		Return(&simplepb.SomeActivity3Response{ResponseVal: "mocked local response"}, nil)
+	env.SignalSomeSignal1After(0)
+	env.SignalSomeSignal2After(0, &simplepb.SomeSignal2Request{RequestVal: "bar"})
+
+	resp, err := env.ExecuteSomeWorkflow1(&simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "some request"})
+	require.NoError(err)
+	for _, item := range []string{
+		"started with param some request",
+		"some activity 3 with response mocked response",
+		"some local activity 3 with response mocked local response",
+		"some signal 1",
+		"some signal 2 with param bar",
+	} {
+		require.Contains(resp.GetResponseVal(), item)
+	}
+
+	query, err := env.QuerySomeQuery2(&simplepb.SomeQuery2Request{RequestVal: "baz"})
+	require.NoError(err)
+	require.Contains(query.GetResponseVal(), "some query 2 with param baz")
+}
+
+func TestTestClient(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	var suite testsuite.WorkflowTestSuite
+	c := simplepb.NewTestClient(func() *simplepb.TestEnv {
+		env := simplepb.NewTestEnv(suite.NewTestWorkflowEnvironment(), &simple.Workflows{}, nil)
+		env.OnSomeActivity3(mock.Anything).
+			Return(&simplepb.SomeActivity3Response{ResponseVal: "mocked response"}, nil)
+		return env
+	})
+
+	run, err := c.ExecuteSomeWorkflow1(ctx, nil, &simplepb.SomeWorkflow1Request{Id: "foo", RequestVal: "some request"})
+	require.NoError(err)
+	require.Regexp(`^some-workflow-1/foo/.+`, run.ID())
+
+	// duplicate executions are rejected until the existing execution completes
+	_, err = c.ExecuteSomeWorkflow1(ctx, &client.StartWorkflowOptions{ID: run.ID()}, &simplepb.SomeWorkflow1Request{Id: "foo"})
+	require.Error(err)
+
+	require.NoError(run.SomeSignal1(ctx))
+	require.NoError(c.SignalSomeSignal2(ctx, run.ID(), run.RunID(), &simplepb.SomeSignal2Request{RequestVal: "bar"}))
+	update, err := c.UpdateSomeUpdate1(ctx, run.ID(), "", &simplepb.SomeUpdate1Request{RequestVal: "baz"})
+	require.NoError(err)
+	require.Contains(update.GetResponseVal(), "some update 1 with param baz")
+
+	resp, err := run.Get(ctx)
+	require.NoError(err)
+	for _, item := range []string{
+		"started with param some request",
+		"some activity 3 with response mocked response",
+		"some signal 1",
+		"some signal 2 with param bar",
+	} {
+		require.Contains(resp.GetResponseVal(), item)
+	}
+
+	query, err := c.QuerySomeQuery1(ctx, run.ID(), "")
+	require.NoError(err)
+	require.Contains(query.GetResponseVal(), "some query 1")
+
+	// signals cannot be sent to completed executions
+	require.Error(run.SomeSignal1(ctx))
+	_, err = c.GetSomeWorkflow1(ctx, "unknown", "")
+	require.Error(err)
+}
+
+func TestSomeWorkflow1CarriedSignals(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	simple.Register(env)
+
+	signal1, err := anypb.New(&emptypb.Empty{})
+	require.NoError(err)
+	signal2, err := anypb.New(&simplepb.SomeSignal2Request{RequestVal: "bar"})
+	require.NoError(err)
+
+	// signals carried over from a previous run are delivered before the workflow completes
+	env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{RequestVal: "foo"}, map[string][]*anypb.Any{
+		simplepb.SomeSignal1SignalName: {signal1},
+		simplepb.SomeSignal2SignalName: {signal2},
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+
+	var resp simplepb.SomeWorkflow1Response
+	require.NoError(env.GetWorkflowResult(&resp))
+	require.Contains(resp.GetResponseVal(), "some signal 1")
+	require.Contains(resp.GetResponseVal(), "some signal 2 with param bar")
+}
+
+// continuingWorkflow1 wraps a SomeWorkflow1 implementation, leaving signals pending until its
+// history grows large enough to continue as new
+type continuingWorkflow1 struct {
+	simplepb.SomeWorkflow1Workflow
+	input *simplepb.SomeWorkflow1Input
+}
+
+func (w *continuingWorkflow1) Execute(ctx workflow.Context) (*simplepb.SomeWorkflow1Response, error) {
+	for !w.input.ShouldContinueAsNew(ctx) {
+		if err := workflow.Sleep(ctx, time.Minute); err != nil {
+			return nil, err
+		}
+	}
+	w.input.Req.RequestVal = "continued"
+	return nil, w.input.ContinueAsNew()
+}
+
+func TestSomeWorkflow1ContinueAsNew(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	simplepb.RegisterSomeWorkflow1Workflow(env, func(ctx workflow.Context, input *simplepb.SomeWorkflow1Input) (simplepb.SomeWorkflow1Workflow, error) {
+		wf, err := (&simple.Workflows{}).SomeWorkflow1(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		return &continuingWorkflow1{SomeWorkflow1Workflow: wf, input: input}, nil
+	})
+
+	// signals received before history crosses max_history_events are left pending
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(simplepb.SomeSignal1SignalName, &emptypb.Empty{})
+		env.SignalWorkflow(simplepb.SomeSignal2SignalName, &simplepb.SomeSignal2Request{RequestVal: "bar"})
+	}, 90*time.Second)
+	env.RegisterDelayedCallback(func() {
+		env.SetCurrentHistoryLength(10000)
+	}, 5*time.Minute)
+	env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{RequestVal: "foo"})
+	require.True(env.IsWorkflowCompleted())
+
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(env.GetWorkflowError(), &canErr)
+	require.Equal(simplepb.SomeWorkflow1WorkflowName, canErr.WorkflowType.Name)
+
+	// the next run receives the current request and the drained signals
+	var req simplepb.SomeWorkflow1Request
+	var carried map[string][]*anypb.Any
+	require.NoError(converter.GetDefaultDataConverter().FromPayloads(canErr.Input, &req, &carried))
+	require.Equal("continued", req.GetRequestVal())
+	require.Len(carried[simplepb.SomeSignal1SignalName], 1)
+	require.Len(carried[simplepb.SomeSignal2SignalName], 1)
+	var signal2 simplepb.SomeSignal2Request
+	require.NoError(carried[simplepb.SomeSignal2SignalName][0].UnmarshalTo(&signal2))
+	require.Equal("bar", signal2.GetRequestVal())
+}
+
+func TestSomeWorkflow1CompletesPastMaxHistoryEvents(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	simple.Register(env)
+	env.SetCurrentHistoryLength(20000)
+
+	// workflows that return successfully complete regardless of history length
+	env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{RequestVal: "foo"})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+
+	var resp simplepb.SomeWorkflow1Response
+	require.NoError(env.GetWorkflowResult(&resp))
+	require.Contains(resp.GetResponseVal(), "started with param foo")
+}
+
+func TestSomeWorkflow1PatchV2Billing(t *testing.T) {
+	for _, c := range []struct {
+		version workflow.Version
+		applied bool
+	}{
+		{version: 1, applied: true},
+		{version: workflow.DefaultVersion, applied: false},
+	} {
+		require := require.New(t)
+
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		simple.Register(env)
+		env.OnGetVersion(simplepb.SomeWorkflow1PatchV2BillingChangeID, workflow.DefaultVersion, 1).Return(c.version)
+
+		env.ExecuteWorkflow(simplepb.SomeWorkflow1WorkflowName, &simplepb.SomeWorkflow1Request{RequestVal: "foo"})
+		require.True(env.IsWorkflowCompleted())
+		require.NoError(env.GetWorkflowError())
+
+		var resp simplepb.SomeWorkflow1Response
+		require.NoError(env.GetWorkflowResult(&resp))
+		require.Equal(c.applied, strings.Contains(resp.GetResponseVal(), "v2-billing patch applied"))
+	}
+}
+
+func TestSomeWorkflow3ContinueAsNew(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		return simplepb.SomeWorkflow3ContinueAsNew(ctx, &simplepb.SomeWorkflow3Request{Id: "foo"})
+	})
+	require.True(env.IsWorkflowCompleted())
+
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(env.GetWorkflowError(), &canErr)
+	require.Equal(simplepb.SomeWorkflow3WorkflowName, canErr.WorkflowType.Name)
+	require.Equal("my-task-queue-2", canErr.TaskQueueName)
+}
+
+func TestSomeWorkflow3ChildID(t *testing.T) {
+	require := require.New(t)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(func(workflow.Context, *simplepb.SomeWorkflow3Request) error {
+		return nil
+	}, workflow.RegisterOptions{Name: simplepb.SomeWorkflow3WorkflowName})
+
+	env.ExecuteWorkflow(func(ctx workflow.Context) (string, error) {
+		run := simplepb.SomeWorkflow3Child(ctx, nil, &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"})
+		exec, err := run.WaitStart(ctx)
+		if err != nil {
+			return "", err
+		}
+		return exec.ID, run.Get(ctx)
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.NoError(env.GetWorkflowError())
+	var id string
+	require.NoError(env.GetWorkflowResult(&id))
+	require.Equal("some-workflow-3/foo/bar", id)
+}
+
+func TestSomeWorkflow3ChildIDError(t *testing.T) {
+	require := require.New(t)
+
+	expr := simplepb.SomeWorkflow3IDExpression
+	simplepb.SomeWorkflow3IDExpression = expression.MustParseExpression(`some-workflow-3/${!throw("invalid id")}`)
+	t.Cleanup(func() { simplepb.SomeWorkflow3IDExpression = expr })
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		run := simplepb.SomeWorkflow3Child(ctx, nil, &simplepb.SomeWorkflow3Request{Id: "foo"})
+		if _, err := run.WaitStart(ctx); err == nil {
+			return errors.New("expected child workflow to fail to start")
+		}
+		if err := run.SomeSignal2(ctx, &simplepb.SomeSignal2Request{}).Get(ctx, nil); err == nil {
+			return errors.New("expected child workflow signal to fail")
+		}
+		return run.Get(ctx)
+	})
+	require.True(env.IsWorkflowCompleted())
+	require.ErrorContains(env.GetWorkflowError(), "invalid id")
+}
+
+func TestReplayChildWorkflowID(t *testing.T) {
+	// testdata/child_id.json was recorded before child workflow ids were evaluated within a side effect
+	replayer := simplepb.NewSimpleReplayer(&simple.Workflows{})
+	replayer.RegisterWorkflowWithOptions(func(ctx workflow.Context) error {
+		return simplepb.SomeWorkflow3Child(ctx, nil, &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"}).Get(ctx)
+	}, workflow.RegisterOptions{Name: "child-id-parent"})
+	require.NoError(t, replayutil.ReplayDir(replayer, nil, "testdata"))
+}
+
+func TestSomeWorkflow3StartOptions(t *testing.T) {
+	require := require.New(t)
+	req := &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"}
+
+	// capture client start options
+	var opts client.StartWorkflowOptions
+	c := &mocks.Client{}
+	c.On("ExecuteWorkflow", mock.Anything, mock.Anything, simplepb.SomeWorkflow3WorkflowName, req).
+		Run(func(args mock.Arguments) { opts = args.Get(1).(client.StartWorkflowOptions) }).
+		Return(&mocks.WorkflowRun{}, nil)
+	_, err := simplepb.NewClient(c).ExecuteSomeWorkflow3(context.Background(), nil, req)
+	require.NoError(err)
+
+	// capture child start options, with and without caller provided options
+	childOpts := func(provided *workflow.ChildWorkflowOptions) workflow.ChildWorkflowOptions {
+		capture := &optionsInterceptor{}
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.SetWorkerOptions(worker.Options{Interceptors: []interceptor.WorkerInterceptor{capture}})
+		env.RegisterWorkflowWithOptions(func(workflow.Context, *simplepb.SomeWorkflow3Request) error {
+			return nil
+		}, workflow.RegisterOptions{Name: simplepb.SomeWorkflow3WorkflowName})
+		env.ExecuteWorkflow(func(ctx workflow.Context) error {
+			return simplepb.SomeWorkflow3Child(ctx, provided, req).Get(ctx)
+		})
+		require.NoError(env.GetWorkflowError())
+		return capture.child
+	}
+	child := childOpts(nil)
+	explicit := childOpts(&workflow.ChildWorkflowOptions{WorkflowExecutionTimeout: time.Minute})
+
+	retryPolicy := &temporal.RetryPolicy{MaximumAttempts: 2}
+	for _, c := range []struct {
+		option   string
+		actual   any
+		expected any
+	}{
+		{"client id", opts.ID, "some-workflow-3/foo/bar"},
+		{"client task_queue", opts.TaskQueue, "my-task-queue-2"},
+		{"client id_reuse_policy", opts.WorkflowIDReusePolicy, enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE},
+		{"client execution_timeout", opts.WorkflowExecutionTimeout, time.Hour},
+		{"client run_timeout", opts.WorkflowRunTimeout, 30 * time.Minute},
+		{"client task_timeout", opts.WorkflowTaskTimeout, 30 * time.Second},
+		{"client retry_policy", opts.RetryPolicy, retryPolicy},
+		{"child id", child.WorkflowID, "some-workflow-3/foo/bar"},
+		{"child task_queue", child.TaskQueue, "my-task-queue-2"},
+		{"child id_reuse_policy", child.WorkflowIDReusePolicy, enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE},
+		{"child execution_timeout", child.WorkflowExecutionTimeout, time.Hour},
+		{"child run_timeout", child.WorkflowRunTimeout, 30 * time.Minute},
+		{"child task_timeout", child.WorkflowTaskTimeout, 30 * time.Second},
+		{"child retry_policy", child.RetryPolicy, retryPolicy},
+		{"child namespace", child.Namespace, "my-namespace"},
+		{"child parent_close_policy", child.ParentClosePolicy, enumspb.PARENT_CLOSE_POLICY_ABANDON},
+		{"child wait_for_cancellation", child.WaitForCancellation, true},
+		{"explicit child execution_timeout", explicit.WorkflowExecutionTimeout, time.Minute},
+		{"explicit child run_timeout", explicit.WorkflowRunTimeout, 30 * time.Minute},
+		{"explicit child wait_for_cancellation", explicit.WaitForCancellation, false},
+	} {
+		require.Equal(c.expected, c.actual, c.option)
+	}
+}
+
+func TestSomeActivity2Options(t *testing.T) {
+	require := require.New(t)
+	req := &simplepb.SomeActivity2Request{RequestVal: "foo"}
+
+	// capture activity options, with and without caller provided options
+	activityOpts := func(provided *workflow.ActivityOptions) workflow.ActivityOptions {
+		capture := &optionsInterceptor{}
+		var suite testsuite.WorkflowTestSuite
+		env := suite.NewTestWorkflowEnvironment()
+		env.SetWorkerOptions(worker.Options{Interceptors: []interceptor.WorkerInterceptor{capture}})
+		env.RegisterActivityWithOptions(func(context.Context, *simplepb.SomeActivity2Request) error {
+			return nil
+		}, activity.RegisterOptions{Name: simplepb.SomeActivity2ActivityName})
+		env.ExecuteWorkflow(func(ctx workflow.Context) error {
+			return simplepb.SomeActivity2(ctx, provided, req).Get(ctx)
+		})
+		require.NoError(env.GetWorkflowError())
+		return capture.activity
+	}
+	defaults := activityOpts(nil)
+	explicit := activityOpts(&workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+
+	for _, c := range []struct {
+		option   string
+		actual   any
+		expected any
+	}{
+		{"activity_id", defaults.ActivityID, "some-activity-2/foo"},
+		{"task_queue", defaults.TaskQueue, "some-activity-2"},
+		{"start_to_close_timeout", defaults.StartToCloseTimeout, 10 * time.Second},
+		{"retry_policy", defaults.RetryPolicy, &temporal.RetryPolicy{MaximumInterval: 30 * time.Second}},
+		{"wait_for_cancellation", defaults.WaitForCancellation, true},
+		{"disable_eager_execution", defaults.DisableEagerExecution, true},
+		{"explicit activity_id", explicit.ActivityID, "some-activity-2/foo"},
+		{"explicit task_queue", explicit.TaskQueue, "some-activity-2"},
+		{"explicit start_to_close_timeout", explicit.StartToCloseTimeout, time.Minute},
+		{"explicit wait_for_cancellation", explicit.WaitForCancellation, false},
+		{"explicit disable_eager_execution", explicit.DisableEagerExecution, false},
+	} {
+		require.Equal(c.expected, c.actual, c.option)
+	}
+}
+
+func TestSomeActivity2LocalOptions(t *testing.T) {
+	require := require.New(t)
+	capture := &optionsInterceptor{}
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetWorkerOptions(worker.Options{Interceptors: []interceptor.WorkerInterceptor{capture}})
+	env.ExecuteWorkflow(func(ctx workflow.Context) error {
+		return simplepb.SomeActivity2Local(ctx, nil, func(context.Context, *simplepb.SomeActivity2Request) error {
+			return nil
+		}, &simplepb.SomeActivity2Request{RequestVal: "foo"}).Get(ctx)
+	})
+	require.NoError(env.GetWorkflowError())
+
+	// local_options take precedence, with unset fields falling back to default_options
+	require.Equal(5*time.Second, capture.localActivity.ScheduleToCloseTimeout)
+	require.Equal(10*time.Second, capture.localActivity.StartToCloseTimeout)
+	require.Equal(int32(3), capture.localActivity.RetryPolicy.MaximumAttempts)
+}
+
+// optionsInterceptor records the options of the last started activity, local activity, and
+// child workflow
+type optionsInterceptor struct {
+	interceptor.WorkerInterceptorBase
+	activity      workflow.ActivityOptions
+	localActivity workflow.LocalActivityOptions
+	child         workflow.ChildWorkflowOptions
+}
+
+func (i *optionsInterceptor) InterceptWorkflow(ctx workflow.Context, next interceptor.WorkflowInboundInterceptor) interceptor.WorkflowInboundInterceptor {
+	return &optionsInbound{WorkflowInboundInterceptorBase: interceptor.WorkflowInboundInterceptorBase{Next: next}, root: i}
+}
+
+type optionsInbound struct {
+	interceptor.WorkflowInboundInterceptorBase
+	root *optionsInterceptor
+}
+
+func (i *optionsInbound) Init(outbound interceptor.WorkflowOutboundInterceptor) error {
+	return i.Next.Init(&optionsOutbound{WorkflowOutboundInterceptorBase: interceptor.WorkflowOutboundInterceptorBase{Next: outbound}, root: i.root})
+}
+
+type optionsOutbound struct {
+	interceptor.WorkflowOutboundInterceptorBase
+	root *optionsInterceptor
+}
+
+func (o *optionsOutbound) ExecuteActivity(ctx workflow.Context, activityType string, args ...interface{}) workflow.Future {
+	o.root.activity = workflow.GetActivityOptions(ctx)
+	return o.Next.ExecuteActivity(ctx, activityType, args...)
+}
+
+func (o *optionsOutbound) ExecuteLocalActivity(ctx workflow.Context, activityType string, args ...interface{}) workflow.Future {
+	o.root.localActivity = workflow.GetLocalActivityOptions(ctx)
+	return o.Next.ExecuteLocalActivity(ctx, activityType, args...)
+}
+
+func (o *optionsOutbound) ExecuteChildWorkflow(ctx workflow.Context, childWorkflowType string, args ...interface{}) workflow.ChildWorkflowFuture {
+	o.root.child = workflow.GetChildWorkflowOptions(ctx)
+	return o.Next.ExecuteChildWorkflow(ctx, childWorkflowType, args...)
+}
+
+func TestCreateSomeWorkflow3Schedule(t *testing.T) {
+	require := require.New(t)
+
+	schedules := &testScheduleClient{}
+	c := &mocks.Client{}
+	c.On("ScheduleClient").Return(schedules)
+
+	handle, err := simplepb.NewClient(c).CreateSomeWorkflow3Schedule(context.Background(), nil,
+		&client.StartWorkflowOptions{ID: "some-workflow-3/foo/bar"},
+		&simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"},
+	)
+	require.NoError(err)
+	require.Equal("some-workflow-3/foo/bar", handle.GetID())
+
+	opts := schedules.created
+	require.Equal([]client.ScheduleIntervalSpec{{Every: time.Hour}}, opts.Spec.Intervals)
+	require.Equal(time.Minute, opts.Spec.Jitter)
+	require.Equal(enumspb.SCHEDULE_OVERLAP_POLICY_SKIP, opts.Overlap)
+	require.Equal(10*time.Minute, opts.CatchupWindow)
+	require.True(opts.PauseOnFailure)
+
+	action, ok := opts.Action.(*client.ScheduleWorkflowAction)
+	require.True(ok)
+	require.Equal(simplepb.SomeWorkflow3WorkflowName, action.Workflow)
+	require.Equal("some-workflow-3/foo/bar", action.ID)
+	require.Equal("my-task-queue-2", action.TaskQueue)
+	require.Equal(int32(2), action.RetryPolicy.MaximumAttempts)
+
+	// pause_on_failure is not applied to caller provided schedule options
+	_, err = simplepb.NewClient(c).CreateSomeWorkflow3Schedule(context.Background(), &client.ScheduleOptions{},
+		&client.StartWorkflowOptions{ID: "some-workflow-3/foo/bar"},
+		&simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"},
+	)
+	require.NoError(err)
+	require.False(schedules.created.PauseOnFailure)
+	require.Equal(time.Minute, schedules.created.Spec.Jitter)
+}
+
+// testScheduleClient records the options of created schedules
+type testScheduleClient struct {
+	client.ScheduleClient
+	created client.ScheduleOptions
+}
+
+func (c *testScheduleClient) Create(ctx context.Context, opts client.ScheduleOptions) (client.ScheduleHandle, error) {
+	c.created = opts
+	return &testScheduleHandle{id: opts.ID}, nil
+}
+
+// testScheduleHandle implements client.ScheduleHandle.GetID
+type testScheduleHandle struct {
+	client.ScheduleHandle
+	id string
+}
+
+func (h *testScheduleHandle) GetID() string {
+	return h.id
+}
+
+func TestActivitiesFromServer(t *testing.T) {
+	require := require.New(t)
+
+	activities := simplepb.NewActivitiesFromServer(&testServer{})
+
+	resp, err := activities.SomeActivity3(context.Background(), &simplepb.SomeActivity3Request{RequestVal: "foo"})
+	require.NoError(err)
+	require.Equal("foo", resp.GetResponseVal())
+
+	err = activities.SomeActivity2(context.Background(), &simplepb.SomeActivity2Request{})
+	var appErr *temporal.ApplicationError
+	require.ErrorAs(err, &appErr)
+	require.Equal(codes.NotFound.String(), appErr.Type())
+	require.True(appErr.NonRetryable())
+}
+
+func TestRemoteActivities(t *testing.T) {
+	require := require.New(t)
+
+	// serve the test server over an in-memory listener
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	simplepb.RegisterSimpleServer(srv, &testServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(err)
+	defer conn.Close()
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	activities := simplepb.NewRemoteActivities(conn, &simple.Activities{})
+	env.RegisterActivityWithOptions(activities.SomeActivity3, activity.RegisterOptions{Name: simplepb.SomeActivity3ActivityName})
+
+	val, err := env.ExecuteActivity(simplepb.SomeActivity3ActivityName, &simplepb.SomeActivity3Request{RequestVal: "foo"})
+	require.NoError(err)
+	var resp simplepb.SomeActivity3Response
+	require.NoError(val.Get(&resp))
+	require.Equal("foo", resp.GetResponseVal())
+
+	// non-remote activities are delegated to the local implementation
+	require.NoError(activities.SomeActivity2(context.Background(), &simplepb.SomeActivity2Request{RequestVal: "bar"}))
+}
+
+func TestWorkflowServer(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// capture the ids of workflows started by the server
+	var ids []string
+	run := &mocks.WorkflowRun{}
+	run.On("GetID").Return("").Maybe()
+	run.On("GetRunID").Return("").Maybe()
+	run.On("Get", mock.Anything, mock.Anything).Return(nil)
+	c := &mocks.Client{}
+	c.On("ExecuteWorkflow", mock.Anything, mock.Anything, simplepb.SomeWorkflow3WorkflowName, mock.Anything).
+		Run(func(args mock.Arguments) { ids = append(ids, args.Get(1).(client.StartWorkflowOptions).ID) }).
+		Return(run, nil)
+	c.On("SignalWorkflow", mock.Anything, "some-workflow-3/foo/bar", "baz", simplepb.SomeSignal1SignalName, nil).Return(nil)
+
+	// serve the workflow server over an in-memory listener
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	simplepb.RegisterSimpleServer(srv, simplepb.NewServer(simplepb.NewClient(c)))
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(err)
+	defer conn.Close()
+	sc := simplepb.NewSimpleClient(conn)
+
+	// workflow ids are read from metadata, falling back to the default id expression
+	req := &simplepb.SomeWorkflow3Request{Id: "foo", RequestVal: "bar"}
+	_, err = sc.SomeWorkflow3(metadata.AppendToOutgoingContext(ctx, grpcutil.WorkflowIDKey, "explicit"), req)
+	require.NoError(err)
+	_, err = sc.SomeWorkflow3(ctx, req)
+	require.NoError(err)
+	require.Equal([]string{"explicit", "some-workflow-3/foo/bar"}, ids)
+
+	// signals, queries, and updates require a workflow id
+	_, err = sc.SomeSignal1(ctx, &emptypb.Empty{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = sc.SomeQuery1(ctx, &emptypb.Empty{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = sc.SomeUpdate1(ctx, &simplepb.SomeUpdate1Request{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = sc.SomeSignal1(metadata.AppendToOutgoingContext(ctx,
+		grpcutil.WorkflowIDKey, "some-workflow-3/foo/bar",
+		grpcutil.RunIDKey, "baz",
+	), &emptypb.Empty{})
+	require.NoError(err)
+	c.AssertExpectations(t)
+}
+
+func TestMockClient(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	run := simplepb.NewMockSomeWorkflow1Run(t)
+	run.OnID().Return("some-workflow-1/foo")
+	run.OnSomeQuery1(mock.Anything).Return(&simplepb.SomeQuery1Response{ResponseVal: "bar"}, nil)
+
+	req := &simplepb.SomeWorkflow1Request{Id: "foo"}
+	c := simplepb.NewMockClient(t)
+	c.OnExecuteSomeWorkflow1(mock.Anything, mock.Anything, req).Return(run, nil).Once()
+	c.OnSignalSomeSignal2(mock.Anything, "some-workflow-1/foo", "", mock.Anything).Return(nil)
+
+	var wc simplepb.Client = c
+	r, err := wc.ExecuteSomeWorkflow1(ctx, nil, req)
+	require.NoError(err)
+	resp, err := r.SomeQuery1(ctx)
+	require.NoError(err)
+	require.Equal("bar", resp.GetResponseVal())
+	require.NoError(wc.SignalSomeSignal2(ctx, r.ID(), "", &simplepb.SomeSignal2Request{RequestVal: "baz"}))
+}
+
+// testServer implements a subset of simplepb.SimpleServer
+type testServer struct {
+	simplepb.UnimplementedSimpleServer
+}
+
+func (s *testServer) SomeActivity2(ctx context.Context, req *simplepb.SomeActivity2Request) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.NotFound, "not found")
+}
+
+func (s *testServer) SomeActivity3(ctx context.Context, req *simplepb.SomeActivity3Request) (*simplepb.SomeActivity3Response, error) {
+	return &simplepb.SomeActivity3Response{ResponseVal: req.GetRequestVal()}, nil
+}